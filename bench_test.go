@@ -0,0 +1,71 @@
+package charmap
+
+import (
+	"testing"
+)
+
+// benchmarkInput returns 64KB of text in the encoding and its UTF-8 form:
+// the defined bytes of the upper half mixed with ASCII letters or,
+// if mostlyASCII is set, separated by runs of 40 ASCII characters.
+func benchmarkInput(e *Encoding, mostlyASCII bool) (encoded, decoded []byte) {
+	var chunk []byte
+	for i := 0; i < 256; i++ {
+		if _, ok := e.codec.DecodeByte(byte(i)); ok && i >= 0x80 {
+			chunk = append(chunk, byte(i), 'a'+byte(i%26))
+			if mostlyASCII {
+				chunk = append(chunk, "The quick brown fox jumps over the dog "...)
+			}
+		}
+	}
+	for len(encoded) < 64<<10 {
+		encoded = append(encoded, chunk...)
+	}
+	decoded, _ = e.DecodeBytes(encoded)
+	return encoded, decoded
+}
+
+func benchmarkDecode(b *testing.B, mostlyASCII bool) {
+	for _, name := range List() {
+		e, _ := Lookup(name)
+		encoded, decoded := benchmarkInput(e, mostlyASCII)
+		dst := make([]byte, 0, len(decoded))
+
+		b.Run(name, func(b *testing.B) {
+			b.SetBytes(int64(len(encoded)))
+			for i := 0; i < b.N; i++ {
+				dst, _ = e.AppendDecode(dst[:0], encoded)
+			}
+		})
+	}
+}
+
+func BenchmarkDecode(b *testing.B) {
+	benchmarkDecode(b, false)
+}
+
+func BenchmarkDecodeMostlyASCII(b *testing.B) {
+	benchmarkDecode(b, true)
+}
+
+func benchmarkEncode(b *testing.B, mostlyASCII bool) {
+	for _, name := range List() {
+		e, _ := Lookup(name)
+		encoded, decoded := benchmarkInput(e, mostlyASCII)
+		dst := make([]byte, 0, len(encoded))
+
+		b.Run(name, func(b *testing.B) {
+			b.SetBytes(int64(len(decoded)))
+			for i := 0; i < b.N; i++ {
+				dst, _ = e.AppendEncode(dst[:0], decoded)
+			}
+		})
+	}
+}
+
+func BenchmarkEncode(b *testing.B) {
+	benchmarkEncode(b, false)
+}
+
+func BenchmarkEncodeMostlyASCII(b *testing.B) {
+	benchmarkEncode(b, true)
+}
@@ -0,0 +1,362 @@
+package charmap
+
+import "fmt"
+
+// builtin describes an encoding defined in one of the codec-*.go files.
+// The list of builtin encodings is always compiled in, so that encodings excluded
+// by build tags (see the package documentation) are reported as such.
+type builtin struct {
+	name      string
+	aliases   []string
+	family    Family
+	scripts   []string
+	languages []string
+	mib       int
+	codePage  int
+	ccsid     int
+
+	// By default a rune is encoded to the lowest byte which decodes to it.
+	// decodeOnly lists the bytes which are not used for encoding, so that a rune shared
+	// by several bytes is encoded to the preferred one. encodeOnly adds mappings of runes
+	// which no byte decodes to, e.g. characters of later revisions of the encoding.
+	decodeOnly []byte
+	encodeOnly []runeByte
+
+	table *[256]rune // nil if the encoding is excluded from the build
+}
+
+var builtins = []builtin{
+	{
+		name: "CP874", family: FamilyWindows,
+		aliases: []string{"CP-874", "874", "WINDOWS-874", "CSWINDOWS874", "DOS-874", "X-WINDOWS-874", "MS874", "IBM874"},
+		scripts: []string{"Thai"}, languages: []string{"th"},
+		mib: 2109, codePage: 874, ccsid: 1162,
+	},
+	{
+		name: "CP1250", family: FamilyWindows,
+		aliases: []string{"CP-1250", "1250", "WINDOWS-1250", "CSWINDOWS1250", "X-CP1250"},
+		scripts: []string{"Latin"}, languages: []string{"cs", "pl", "hu", "sk", "sl", "hr", "ro", "sq"},
+		mib: 2250, codePage: 1250, ccsid: 1250,
+	},
+	{
+		name: "CP1251", family: FamilyWindows,
+		aliases: []string{"CP-1251", "1251", "WINDOWS-1251", "CSWINDOWS1251", "X-CP1251"},
+		scripts: []string{"Cyrillic"}, languages: []string{"ru", "uk", "be", "bg", "sr", "mk"},
+		mib: 2251, codePage: 1251, ccsid: 1251,
+	},
+	{
+		name: "CP1252", family: FamilyWindows,
+		aliases: []string{"CP-1252", "1252", "WINDOWS-1252", "CSWINDOWS1252", "X-CP1252"},
+		scripts: []string{"Latin"}, languages: []string{"en", "fr", "de", "es", "it", "pt", "nl", "da", "no", "sv", "fi", "is"},
+		mib: 2252, codePage: 1252, ccsid: 1252,
+	},
+	{
+		name: "CP1253", family: FamilyWindows,
+		aliases: []string{"CP-1253", "1253", "WINDOWS-1253", "CSWINDOWS1253", "X-CP1253"},
+		scripts: []string{"Greek"}, languages: []string{"el"},
+		mib: 2253, codePage: 1253, ccsid: 1253,
+	},
+	{
+		name: "CP1254", family: FamilyWindows,
+		aliases: []string{"CP-1254", "1254", "WINDOWS-1254", "CSWINDOWS1254", "X-CP1254"},
+		scripts: []string{"Latin"}, languages: []string{"tr"},
+		mib: 2254, codePage: 1254, ccsid: 1254,
+	},
+	{
+		name: "CP1255", family: FamilyWindows,
+		aliases: []string{"CP-1255", "1255", "WINDOWS-1255", "CSWINDOWS1255", "X-CP1255"},
+		scripts: []string{"Hebrew"}, languages: []string{"he", "yi"},
+		mib: 2255, codePage: 1255, ccsid: 1255,
+	},
+	{
+		name: "CP1256", family: FamilyWindows,
+		aliases: []string{"CP-1256", "1256", "WINDOWS-1256", "CSWINDOWS1256", "X-CP1256"},
+		scripts: []string{"Arabic"}, languages: []string{"ar", "fa", "ur"},
+		mib: 2256, codePage: 1256, ccsid: 1256,
+	},
+	{
+		name: "CP1257", family: FamilyWindows,
+		aliases: []string{"CP-1257", "1257", "WINDOWS-1257", "CSWINDOWS1257", "X-CP1257"},
+		scripts: []string{"Latin"}, languages: []string{"et", "lv", "lt"},
+		mib: 2257, codePage: 1257, ccsid: 1257,
+	},
+	{
+		name: "CP1258", family: FamilyWindows,
+		aliases: []string{"CP-1258", "1258", "WINDOWS-1258", "CSWINDOWS1258", "X-CP1258"},
+		scripts: []string{"Latin"}, languages: []string{"vi"},
+		mib: 2258, codePage: 1258, ccsid: 1258,
+	},
+	{
+		name: "CP437", family: FamilyDOS,
+		aliases: []string{"CP-437", "437", "IBM437", "CSPC8CODEPAGE437"},
+		scripts: []string{"Latin"}, languages: []string{"en"},
+		mib: 2011, codePage: 437, ccsid: 437,
+	},
+	{
+		name: "CP737", family: FamilyDOS,
+		aliases: []string{"CP-737", "737", "IBM737", "X-IBM737"},
+		scripts: []string{"Greek"}, languages: []string{"el"},
+		codePage: 737, ccsid: 737,
+	},
+	{
+		name: "CP775", family: FamilyDOS,
+		aliases: []string{"CP-775", "775", "IBM775", "CSPC775BALTIC"},
+		scripts: []string{"Latin"}, languages: []string{"et", "lv", "lt"},
+		mib: 2087, codePage: 775, ccsid: 775,
+	},
+	{
+		name: "CP850", family: FamilyDOS,
+		aliases: []string{"CP-850", "850", "IBM850", "CSPC850MULTILINGUAL"},
+		scripts: []string{"Latin"}, languages: []string{"en", "fr", "de", "es", "it", "pt", "nl", "da", "no", "sv", "fi", "is"},
+		mib: 2009, codePage: 850, ccsid: 850,
+	},
+	{
+		name: "CP852", family: FamilyDOS,
+		aliases: []string{"CP-852", "852", "IBM852", "CSPCP852"},
+		scripts: []string{"Latin"}, languages: []string{"cs", "pl", "hu", "sk", "sl", "hr", "ro", "sq"},
+		mib: 2010, codePage: 852, ccsid: 852,
+	},
+	{
+		name: "CP856", family: FamilyDOS,
+		aliases: []string{"CP-856", "856", "IBM856", "X-IBM856"},
+		scripts: []string{"Hebrew"}, languages: []string{"he"},
+		ccsid: 856,
+	},
+	{
+		name: "CP857", family: FamilyDOS,
+		aliases: []string{"CP-857", "857", "IBM857", "CSIBM857"},
+		scripts: []string{"Latin"}, languages: []string{"tr"},
+		mib: 2047, codePage: 857, ccsid: 857,
+	},
+	{
+		name: "CP860", family: FamilyDOS,
+		aliases: []string{"CP-860", "860", "IBM860", "CSIBM860"},
+		scripts: []string{"Latin"}, languages: []string{"pt"},
+		mib: 2048, codePage: 860, ccsid: 860,
+	},
+	{
+		name: "CP861", family: FamilyDOS,
+		aliases: []string{"CP-861", "861", "IBM861", "CP-IS", "CSIBM861"},
+		scripts: []string{"Latin"}, languages: []string{"is"},
+		mib: 2049, codePage: 861, ccsid: 861,
+	},
+	{
+		name: "CP862", family: FamilyDOS,
+		aliases: []string{"CP-862", "862", "IBM862", "CSPC862LATINHEBREW"},
+		scripts: []string{"Hebrew"}, languages: []string{"he"},
+		mib: 2013, codePage: 862, ccsid: 862,
+	},
+	{
+		name: "CP863", family: FamilyDOS,
+		aliases: []string{"CP-863", "863", "IBM863", "CSIBM863"},
+		scripts: []string{"Latin"}, languages: []string{"fr"},
+		mib: 2050, codePage: 863, ccsid: 863,
+	},
+	{
+		name: "CP864", family: FamilyDOS,
+		aliases: []string{"CP-864", "864", "IBM864", "CSIBM864"},
+		scripts: []string{"Arabic"}, languages: []string{"ar"},
+		mib: 2051, codePage: 864, ccsid: 864,
+	},
+	{
+		name: "CP865", family: FamilyDOS,
+		aliases: []string{"CP-865", "865", "IBM865", "CSIBM865"},
+		scripts: []string{"Latin"}, languages: []string{"da", "no"},
+		mib: 2052, codePage: 865, ccsid: 865,
+	},
+	{
+		name: "CP866", family: FamilyDOS,
+		aliases: []string{"CP-866", "866", "IBM866", "CSIBM866"},
+		scripts: []string{"Cyrillic"}, languages: []string{"ru", "uk", "be", "bg"},
+		mib: 2086, codePage: 866, ccsid: 866,
+	},
+	{
+		name: "CP869", family: FamilyDOS,
+		aliases: []string{"CP-869", "869", "IBM869", "CP-GR", "CSIBM869"},
+		scripts: []string{"Greek"}, languages: []string{"el"},
+		mib: 2054, codePage: 869, ccsid: 869,
+	},
+	{
+		name: "CP1006", family: FamilyDOS,
+		aliases: []string{"CP-1006", "1006", "IBM1006", "X-IBM1006"},
+		scripts: []string{"Arabic"}, languages: []string{"ur"},
+		ccsid: 1006,
+		// 0xB1 and 0xB2 are both ARABIC LETTER ALEF FINAL FORM, encode to 0xB2 as Python does
+		decodeOnly: []byte{0xB1},
+	},
+	{
+		name: "ISO-8859-1", family: FamilyISO,
+		aliases: []string{"8859-1", "ISO8859-1", "ISO_8859-1:1987", "ISO-IR-100", "LATIN1", "LATIN-1", "LATIN", "L1", "8859", "ISO8859", "IBM819", "CP819", "CSISOLATIN1"},
+		scripts: []string{"Latin"}, languages: []string{"en", "fr", "de", "es", "it", "pt", "nl", "da", "no", "sv", "fi", "is"},
+		mib: 4, codePage: 28591, ccsid: 819,
+	},
+	{
+		name: "ISO-8859-2", family: FamilyISO,
+		aliases: []string{"8859-2", "ISO8859-2", "ISO_8859-2:1987", "ISO-IR-101", "LATIN2", "L2", "IBM912", "CP912", "CSISOLATIN2"},
+		scripts: []string{"Latin"}, languages: []string{"cs", "pl", "hu", "sk", "sl", "hr", "ro", "sq"},
+		mib: 5, codePage: 28592, ccsid: 912,
+	},
+	{
+		name: "ISO-8859-3", family: FamilyISO,
+		aliases: []string{"8859-3", "ISO8859-3", "ISO_8859-3:1988", "ISO-IR-109", "LATIN3", "L3", "IBM913", "CP913", "CSISOLATIN3"},
+		scripts: []string{"Latin"}, languages: []string{"mt", "eo", "tr"},
+		mib: 6, codePage: 28593, ccsid: 913,
+	},
+	{
+		name: "ISO-8859-4", family: FamilyISO,
+		aliases: []string{"8859-4", "ISO8859-4", "ISO_8859-4:1988", "ISO-IR-110", "LATIN4", "L4", "IBM914", "CP914", "CSISOLATIN4"},
+		scripts: []string{"Latin"}, languages: []string{"et", "lv", "lt", "kl", "se"},
+		mib: 7, codePage: 28594, ccsid: 914,
+	},
+	{
+		name: "ISO-8859-5", family: FamilyISO,
+		aliases: []string{"8859-5", "ISO8859-5", "ISO_8859-5:1988", "ISO-IR-144", "CYRILLIC", "IBM915", "CP915", "CSISOLATINCYRILLIC"},
+		scripts: []string{"Cyrillic"}, languages: []string{"ru", "uk", "be", "bg", "sr", "mk"},
+		mib: 8, codePage: 28595, ccsid: 915,
+	},
+	{
+		name: "ISO-8859-6", family: FamilyISO,
+		aliases: []string{"8859-6", "ISO8859-6", "ISO_8859-6:1987", "ISO-IR-127", "ECMA-114", "ASMO-708", "ARABIC", "IBM1089", "CP1089", "CSISOLATINARABIC", "ISO-8859-6-E", "ISO-8859-6-I", "CSISO88596E", "CSISO88596I"},
+		scripts: []string{"Arabic"}, languages: []string{"ar"},
+		mib: 9, codePage: 28596, ccsid: 1089,
+	},
+	{
+		name: "ISO-8859-7", family: FamilyISO,
+		aliases: []string{"8859-7", "ISO8859-7", "ISO_8859-7:1987", "ISO-IR-126", "ELOT_928", "ECMA-118", "GREEK", "GREEK8", "IBM813", "CP813", "CSISOLATINGREEK", "SUN_EU_GREEK"},
+		scripts: []string{"Greek"}, languages: []string{"el"},
+		mib: 10, codePage: 28597, ccsid: 813,
+	},
+	{
+		name: "ISO-8859-8", family: FamilyISO,
+		aliases: []string{"8859-8", "ISO8859-8", "ISO_8859-8:1988", "ISO-IR-138", "HEBREW", "IBM916", "CP916", "CSISOLATINHEBREW", "ISO-8859-8-E", "CSISO88598E", "VISUAL", "ISO-8859-8-I", "CSISO88598I", "LOGICAL"},
+		scripts: []string{"Hebrew"}, languages: []string{"he"},
+		mib: 11, codePage: 28598, ccsid: 916,
+	},
+	{
+		name: "ISO-8859-9", family: FamilyISO,
+		aliases: []string{"8859-9", "ISO8859-9", "ISO_8859-9:1989", "ISO-IR-148", "LATIN5", "L5", "IBM920", "CP920", "CSISOLATIN5"},
+		scripts: []string{"Latin"}, languages: []string{"tr"},
+		mib: 12, codePage: 28599, ccsid: 920,
+	},
+	{
+		name: "ISO-8859-10", family: FamilyISO,
+		aliases: []string{"8859-10", "ISO8859-10", "ISO_8859-10:1992", "ISO-IR-157", "LATIN6", "L6", "IBM919", "CP919", "CSISOLATIN6"},
+		scripts: []string{"Latin"}, languages: []string{"is", "kl", "se", "da", "no", "sv", "fi"},
+		mib: 13, ccsid: 919,
+	},
+	{
+		name: "ISO-8859-11", family: FamilyISO,
+		aliases: []string{"8859-11", "ISO8859-11", "ISO_8859-11:2001", "TIS-620", "TIS-620-0", "TIS-620-2529-0", "TIS-620-2529-1", "ISO-IR-166", "CSTIS620", "THAI", "X-ISO-8859-11"},
+		scripts: []string{"Thai"}, languages: []string{"th"},
+		mib: 2259,
+	},
+	{
+		name: "ISO-8859-13", family: FamilyISO,
+		aliases: []string{"8859-13", "ISO8859-13", "LATIN7", "L7", "IBM921", "CP921", "CSISO885913"},
+		scripts: []string{"Latin"}, languages: []string{"et", "lv", "lt", "pl"},
+		mib: 109, codePage: 28603, ccsid: 921,
+	},
+	{
+		name: "ISO-8859-14", family: FamilyISO,
+		aliases: []string{"8859-14", "ISO8859-14", "ISO_8859-14:1998", "ISO-IR-199", "LATIN8", "L8", "ISO-CELTIC", "CSISO885914"},
+		scripts: []string{"Latin"}, languages: []string{"ga", "cy", "gd", "br"},
+		mib: 110,
+	},
+	{
+		name: "ISO-8859-15", family: FamilyISO,
+		aliases: []string{"8859-15", "ISO8859-15", "LATIN-9", "LATIN0", "L9", "IBM923", "CP923", "CSISO885915", "CSISOLATIN9"},
+		scripts: []string{"Latin"}, languages: []string{"en", "fr", "de", "es", "it", "pt", "nl", "da", "no", "sv", "fi", "is"},
+		mib: 111, codePage: 28605, ccsid: 923,
+	},
+	{
+		name: "ISO-8859-16", family: FamilyISO,
+		aliases: []string{"8859-16", "ISO8859-16", "ISO_8859-16:2001", "ISO-IR-226", "LATIN10", "L10", "CSISO885916"},
+		scripts: []string{"Latin"}, languages: []string{"ro", "hu", "pl", "hr", "sl", "sq"},
+		mib: 112,
+	},
+	{
+		name: "MAC-CYRILLIC", family: FamilyMac,
+		aliases: []string{"MACCYRILLIC", "X-MAC-CYRILLIC", "X-MAC-UKRAINIAN"},
+		scripts: []string{"Cyrillic"}, languages: []string{"ru", "uk", "be", "bg", "sr", "mk"},
+		codePage: 10007, ccsid: 1283,
+		// the characters which later revisions and the Ukrainian variant put in place of ¢, ∂ and ¤
+		encodeOnly: []runeByte{{'\u0490', 0xA2}, {'\u0491', 0xB6}, {'\u20AC', 0xFF}},
+	},
+	{
+		name: "MAC-GREEK", family: FamilyMac,
+		aliases: []string{"MACGREEK", "X-MAC-GREEK"},
+		scripts: []string{"Greek"}, languages: []string{"el"},
+		codePage: 10006, ccsid: 1280,
+	},
+	{
+		name: "MAC-ICELAND", family: FamilyMac,
+		aliases: []string{"MACICELAND", "X-MAC-ICELANDIC", "X-MAC-ICELAND"},
+		scripts: []string{"Latin"}, languages: []string{"is"},
+		codePage: 10079, ccsid: 1286,
+	},
+	{
+		name: "MAC-LATIN2", family: FamilyMac,
+		aliases: []string{"MACLATIN2", "X-MAC-CE", "X-MAC-CENTRALEUROPE", "MAC-CENTEURO", "MACCENTRALEUROPE"},
+		scripts: []string{"Latin"}, languages: []string{"cs", "pl", "hu", "sk", "sl", "et", "lv", "lt"},
+		codePage: 10029, ccsid: 1282,
+	},
+	{
+		name: "MAC-ROMAN", family: FamilyMac,
+		aliases: []string{"MACROMAN", "MACINTOSH", "MAC", "CSMACINTOSH", "X-MAC-ROMAN"},
+		scripts: []string{"Latin"}, languages: []string{"en", "fr", "de", "es", "it", "pt", "nl", "da", "no", "sv", "fi", "is"},
+		mib: 2027, codePage: 10000, ccsid: 1275,
+	},
+	{
+		name: "MAC-TURKISH", family: FamilyMac,
+		aliases: []string{"MACTURKISH", "X-MAC-TURKISH"},
+		scripts: []string{"Latin"}, languages: []string{"tr"},
+		codePage: 10081, ccsid: 1281,
+	},
+	{
+		name: "KOI8-R", family: FamilyKOI,
+		aliases: []string{"KOI8R", "CSKOI8R", "KOI", "KOI8"},
+		scripts: []string{"Cyrillic"}, languages: []string{"ru", "bg"},
+		mib: 2084, codePage: 20866, ccsid: 878,
+	},
+	{
+		name: "KOI8-U", family: FamilyKOI,
+		aliases: []string{"KOI8U", "CSKOI8U", "KOI8-RU"},
+		scripts: []string{"Cyrillic"}, languages: []string{"uk", "ru", "be"},
+		mib: 2088, codePage: 21866, ccsid: 1168,
+	},
+}
+
+// setBuiltinTable is called by the codec files which are compiled in.
+func setBuiltinTable(name string, table *[256]rune) {
+	for i := range builtins {
+		if builtins[i].name == name {
+			builtins[i].table = table
+			return
+		}
+	}
+	panic("charmap: unknown builtin encoding " + name)
+}
+
+// excludedError returns the error for a builtin encoding which is excluded from the build
+// by build tags, or nil if name is not the name or an alias of such an encoding.
+func excludedError(name string) error {
+	for _, b := range builtins {
+		if b.table == nil && b.hasName(name) {
+			return fmt.Errorf("%w: %s (excluded by build tags)", ErrUnknownEncoding, b.name)
+		}
+	}
+	return nil
+}
+
+func (b *builtin) hasName(name string) bool {
+	key := lookupKey(name)
+	if lookupKey(b.name) == key {
+		return true
+	}
+	for _, alias := range b.aliases {
+		if lookupKey(alias) == key {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,94 @@
+package charmap
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestBuiltins(t *testing.T) {
+	compiled := make(map[string]bool)
+	for _, name := range List() {
+		compiled[name] = true
+	}
+
+	n := 0
+	for _, b := range builtins {
+		if b.table != nil {
+			n++
+			if !compiled[b.name] {
+				t.Errorf("builtin %s: missing in List", b.name)
+			}
+			continue
+		}
+
+		if compiled[b.name] {
+			t.Errorf("excluded builtin %s: present in List", b.name)
+		}
+		for _, name := range append([]string{b.name}, b.aliases...) {
+			_, err := Lookup(name)
+			if !errors.Is(err, ErrUnknownEncoding) || !strings.Contains(err.Error(), "build tags") {
+				t.Errorf("excluded builtin %s: wrong error value %v", name, err)
+			}
+		}
+	}
+	if n == 0 {
+		t.Error("no builtin encodings compiled in")
+	}
+}
+
+func TestEncodeMappings(t *testing.T) {
+	if compiledIn("cp1006") {
+		test_str, err := Encode("ﺎ", "cp1006")
+		if err != nil || test_str != "\xB2" {
+			t.Error("encoding alef final form to cp1006: wrong result")
+		}
+		test_str, err = Decode("\xB1\xB2", "cp1006")
+		if err != nil || test_str != "ﺎﺎ" {
+			t.Error("decoding alef final forms from cp1006: wrong result")
+		}
+	}
+
+	if compiledIn("mac-cyrillic") {
+		test_str, err := Encode("€ Ґґ ¤", "mac-cyrillic")
+		if err != nil || test_str != "\xFF \xA2\xB6 \xFF" {
+			t.Error("encoding encode-only characters to mac-cyrillic: wrong result")
+		}
+		test_str, err = Decode("\xFF\xA2\xB6", "mac-cyrillic")
+		if err != nil || test_str != "¤¢∂" {
+			t.Error("decoding mac-cyrillic: wrong result")
+		}
+	}
+
+	// the encoding of every builtin codec is determined by its data:
+	// a rune is shared by several bytes only if all but one are decode-only
+	for _, b := range builtins {
+		if b.table == nil {
+			continue
+		}
+
+		var skip [256]bool
+		for _, c := range b.decodeOnly {
+			skip[c] = true
+		}
+		encodedTo := make(map[rune]int)
+		for i, r := range b.table {
+			if r != undefinedRune && !skip[i] {
+				if prev, ok := encodedTo[r]; ok {
+					t.Errorf("%s: %U is decoded from 0x%02X and 0x%02X", b.name, r, prev, i)
+				}
+				encodedTo[r] = i
+			}
+		}
+		for _, c := range b.decodeOnly {
+			if _, ok := encodedTo[b.table[c]]; !ok {
+				t.Errorf("%s: decode-only byte 0x%02X cannot be encoded", b.name, c)
+			}
+		}
+		for _, m := range b.encodeOnly {
+			if _, ok := encodedTo[m.r]; ok {
+				t.Errorf("%s: encode-only %U is in the table", b.name, m.r)
+			}
+		}
+	}
+}
@@ -1,139 +1,321 @@
 // Package charmap implements functions for character encodings conversion.
 // A number of 8bit encodings are supported. The package provides Encode and
 // Decode functions to convert a string from and to UTF-8 respectively.
+//
+// The encodings are grouped into families which can be left out of the build
+// with build tags, e.g. go build -tags charmap_no_mac:
+//
+//	charmap_no_windows     CP874, CP1250 - CP1258
+//	charmap_no_dos         CP437 - CP869, CP1006
+//	charmap_no_iso8859     ISO-8859-*
+//	charmap_no_mac         MAC-*
+//	charmap_no_koi         KOI8-R, KOI8-U
+//	charmap_only_cyrillic  only CP1251, CP866, ISO-8859-5, KOI8-R, KOI8-U and MAC-CYRILLIC
+//
+// List returns only the encodings which are compiled in; for the excluded ones
+// the conversion functions return an error wrapping ErrUnknownEncoding.
 package charmap
 
 import (
-	"bytes"
 	"errors"
+	"fmt"
+	"sort"
 	"strings"
+	"sync"
+	"unicode"
 	"unicode/utf8"
 )
 
-var aliasesMap = make(map[string]string)
-var codecsMap = make(map[string]codec)
+var registryMu sync.RWMutex
+var registryOnce sync.Once
+var aliasesMap map[string]string // alias -> canonical name
+var keysMap map[string]string    // lookup key of a name or alias -> canonical name
+var codecsMap map[string]*Encoding
 
-type codec interface {
-	Encode(data string) (string, error)
-	Decode(data string) (string, error)
+// loadRegistry fills the registry with the builtin encodings on first use,
+// so importing the package costs nothing. It must be called before the maps are accessed.
+func loadRegistry() {
+	registryOnce.Do(func() {
+		aliasesMap = make(map[string]string)
+		keysMap = make(map[string]string)
+		codecsMap = make(map[string]*Encoding, len(builtins))
+
+		for i := range builtins {
+			b := &builtins[i]
+			if b.table == nil {
+				continue
+			}
+			codecsMap[b.name] = &Encoding{name: b.name, builtin: b}
+			keysMap[lookupKey(b.name)] = b.name
+			for _, alias := range b.aliases {
+				aliasesMap[alias] = b.name
+				keysMap[lookupKey(alias)] = b.name
+			}
+		}
+	})
 }
 
-func register(c codec, name string, aliases ...string) {
-	codecsMap[name] = c
-	for _, alias := range aliases {
-		aliasesMap[alias] = name
-	}
+// Codec is the interface implemented by 8bit encodings.
+// EncodeRune returns the byte for the rune r and DecodeByte returns the rune for the byte c;
+// both report false if the character is not defined in the encoding.
+// A Codec must be safe for concurrent use.
+type Codec interface {
+	EncodeRune(r rune) (byte, bool)
+	DecodeByte(c byte) (rune, bool)
 }
 
 var ErrUnknownEncoding error = errors.New("encoding is not supported")
 var ErrInvalidCodepoint error = errors.New("cannot convert one or more codepoints")
+var ErrAlreadyRegistered error = errors.New("encoding name is already registered")
+
+// Register adds a codec to the list of supported encodings under the specified name and aliases.
+// Names are normalized in the same way as in Encode and Decode: case, whitespace,
+// dashes, underscores and colons do not matter when an encoding is resolved.
+// If the name or one of the aliases is already used by another encoding,
+// nothing is registered and ErrAlreadyRegistered is returned.
+// Register is safe to call concurrently with conversion functions.
+func Register(name string, c Codec, aliases ...string) error {
+	if c == nil {
+		return errors.New("charmap: Register codec is nil")
+	}
+
+	return registerChecked(c, name, aliases, false)
+}
+
+// registerChecked registers c if none of its names is used yet.
+// If dropTaken is set, aliases which are already used are left out instead.
+func registerChecked(c Codec, name string, aliases []string, dropTaken bool) error {
+	name = normalizeName(name)
+	if lookupKey(name) == "" {
+		return errors.New("charmap: Register name is empty")
+	}
+	for _, alias := range aliases {
+		if lookupKey(alias) == "" {
+			return errors.New("charmap: Register alias is empty")
+		}
+	}
+
+	loadRegistry()
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	taken := func(n string) bool {
+		_, ok := keysMap[lookupKey(n)]
+		return ok
+	}
+
+	if taken(name) {
+		return fmt.Errorf("%w: %s", ErrAlreadyRegistered, name)
+	}
+
+	keys := map[string]bool{lookupKey(name): true}
+	names := make([]string, 0, len(aliases))
+	for _, alias := range aliases {
+		alias = normalizeName(alias)
+		if alias == name {
+			continue
+		}
+		if key := lookupKey(alias); !keys[key] {
+			if taken(alias) {
+				if dropTaken {
+					continue
+				}
+				return fmt.Errorf("%w: %s", ErrAlreadyRegistered, alias)
+			}
+			keys[key] = true
+		}
+		names = append(names, alias)
+	}
 
-// List returns a list of all supported encodings as a slice of strings
+	codecsMap[name] = &Encoding{name: name, codec: c}
+	for key := range keys {
+		keysMap[key] = name
+	}
+	for _, alias := range names {
+		aliasesMap[alias] = name
+	}
+	return nil
+}
+
+// List returns a sorted list of all supported encodings as a slice of strings
 func List() []string {
-	list := make([]string, 0)
+	loadRegistry()
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	list := make([]string, 0, len(codecsMap))
 	for name, _ := range codecsMap {
 		list = append(list, name)
 	}
+	sort.Strings(list)
 	return list
 }
 
-func getCodecForEncoding(encoding string) string {
-	encoding = strings.ToUpper(encoding)
+func normalizeName(encoding string) string {
+	encoding = strings.ToUpper(strings.TrimSpace(encoding))
 	encoding = strings.Replace(encoding, "_", "-", -1)
+	return encoding
+}
 
-	if name, ok := aliasesMap[encoding]; ok {
-		encoding = name
-	}
+// lookupKey returns the form of an encoding name used to resolve it: upper case without
+// whitespace, dashes, underscores and colons, so "ISO 8859-1", "iso_8859_1" and "ISO88591"
+// are the same, as are "ISO_8859-1:1987" and "iso_8859_1_1987".
+func lookupKey(encoding string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == '_' || r == ':' || unicode.IsSpace(r) {
+			return -1
+		}
+		return unicode.ToUpper(r)
+	}, encoding)
+}
 
-	return encoding
+func getCodecForEncoding(encoding string) string {
+	loadRegistry()
+	registryMu.RLock()
+	name, ok := keysMap[lookupKey(encoding)]
+	registryMu.RUnlock()
+
+	if ok {
+		return name
+	}
+	return normalizeName(encoding)
 }
 
 // Encode converts a string from UTF-8 to the specified encoding. Returns converted string.
 // If the input string contains illegal characters for the specified encoding,
 // these characters will be replaced with a substitute character ('?') and
-// ErrInvalidCodepoint will be returned in error value.
+// a *ConversionError wrapping ErrInvalidCodepoint will be returned in error value.
+// The handling of illegal characters can be changed with options such as Strict or XMLCharRefReplace.
 // If the specified encoding is unknown, it will return the input string and ErrUnknownEncoding
-func Encode(data string, encoding string) (string, error) {
-	encoding = getCodecForEncoding(encoding)
-
-	if codec, ok := codecsMap[encoding]; ok {
-		result, err := codec.Encode(data)
-		return result, err
+func Encode(data string, encoding string, opts ...Option) (string, error) {
+	e, err := Lookup(encoding)
+	if err != nil {
+		return data, err
 	}
 
-	return data, ErrUnknownEncoding
+	return e.Encode(data, opts...)
 }
 
 // Decode converts a string from the specified encoding to UTF-8.  Returns converted string.
 // If the input string contains illegal characters for the specified encoding,
 // these characters will be replaced with a substitute character (utf8.RuneError) and
-// ErrInvalidCodepoint will be returned in error value.
+// a *ConversionError wrapping ErrInvalidCodepoint will be returned in error value.
+// The handling of illegal characters can be changed with options such as Strict or ReplaceRune.
 // If the specified encoding is unknown, it will return the input string and ErrUnknownEncoding
-func Decode(data string, encoding string) (string, error) {
-	encoding = getCodecForEncoding(encoding)
-
-	if codec, ok := codecsMap[encoding]; ok {
-		result, err := codec.Decode(data)
-		return result, err
+func Decode(data string, encoding string, opts ...Option) (string, error) {
+	e, err := Lookup(encoding)
+	if err != nil {
+		return data, err
 	}
 
-	return data, ErrUnknownEncoding
+	return e.Decode(data, opts...)
 }
 
-// simple 8bit codecs definition support
+// EncodeBytes is like Encode but converts a byte slice.
+// If the specified encoding is unknown, it will return the input slice and ErrUnknownEncoding
+func EncodeBytes(data []byte, encoding string, opts ...Option) ([]byte, error) {
+	e, err := Lookup(encoding)
+	if err != nil {
+		return data, err
+	}
+
+	return e.EncodeBytes(data, opts...)
+}
 
-func reverseByteRuneMap(m map[byte]rune) (newmap map[rune]byte) {
-	newmap = make(map[rune]byte, len(m))
-	for k, v := range m {
-		newmap[v] = k
+// DecodeBytes is like Decode but converts a byte slice.
+// If the specified encoding is unknown, it will return the input slice and ErrUnknownEncoding
+func DecodeBytes(data []byte, encoding string, opts ...Option) ([]byte, error) {
+	e, err := Lookup(encoding)
+	if err != nil {
+		return data, err
 	}
-	return
+
+	return e.DecodeBytes(data, opts...)
 }
 
-func mapBytesToRunes(cm map[byte]rune, data string) (result string, err error) {
-	size := len(data)
-	buf := bytes.NewBuffer(make([]byte, 0, size))
+// AppendEncode converts src from UTF-8 to the specified encoding, appends the result
+// to dst and returns the extended buffer. If dst has enough capacity, no allocation is made.
+// Errors are reported as in Encode. If the specified encoding is unknown,
+// src is appended unchanged and ErrUnknownEncoding is returned.
+func AppendEncode(dst, src []byte, encoding string, opts ...Option) ([]byte, error) {
+	e, err := Lookup(encoding)
+	if err != nil {
+		return append(dst, src...), err
+	}
 
-	for i := 0; i < size; i++ {
-		c := data[i]
-		if r, ok := cm[c]; ok {
-			buf.WriteRune(r)
-		} else {
-			err = ErrInvalidCodepoint
-			buf.WriteRune(utf8.RuneError)
-		}
+	return e.AppendEncode(dst, src, opts...)
+}
+
+// AppendDecode converts src from the specified encoding to UTF-8, appends the result
+// to dst and returns the extended buffer. If dst has enough capacity, no allocation is made.
+// Errors are reported as in Decode. If the specified encoding is unknown,
+// src is appended unchanged and ErrUnknownEncoding is returned.
+func AppendDecode(dst, src []byte, encoding string, opts ...Option) ([]byte, error) {
+	e, err := Lookup(encoding)
+	if err != nil {
+		return append(dst, src...), err
 	}
 
-	result = buf.String()
-	return result, err
+	return e.AppendDecode(dst, src, opts...)
 }
 
-func mapRunesToBytes(cm map[rune]byte, data string) (result string, err error) {
-	size := len(data)
-	buf := bytes.NewBuffer(make([]byte, 0, size/2))
+func mapBytesToRunes(c Codec, dst, src []byte, o *options) ([]byte, *ConversionError) {
+	var cerr *ConversionError
+
+	c = o.codec(c)
+	if cm, ok := c.(*codecMap8Bit); ok {
+		return cm.appendDecode(dst, src, o)
+	}
+
+	for i, b := range src {
+		r, ok := c.DecodeByte(b)
+		if ok {
+			dst = utf8.AppendRune(dst, r)
+			continue
+		}
 
-	for _, r := range data {
-		if c, ok := cm[r]; ok {
-			buf.WriteByte(c)
-		} else {
-			err = ErrInvalidCodepoint
-			buf.WriteByte('?')
+		cerr = cerr.record("decode", i, i, b, 0)
+		if o.decodeErr == nil {
+			break
 		}
+		dst = o.decodeErr(dst, b)
 	}
 
-	result = buf.String()
-	return result, err
+	return dst, cerr
 }
 
-type codecMap8Bit struct {
-	EncodeMap map[rune]byte
-	DecodeMap map[byte]rune
-}
+func mapRunesToBytes(c Codec, dst, src []byte, o *options) ([]byte, *ConversionError) {
+	var cerr *ConversionError
+
+	c = o.codec(c)
+	if cm, ok := c.(*codecMap8Bit); ok {
+		return cm.appendEncode(dst, src, o)
+	}
+
+	for i, n := 0, 0; i < len(src); n++ {
+		r, size := utf8.DecodeRune(src[i:])
+
+		b, ok := c.EncodeRune(r)
+		if ok {
+			dst = append(dst, b)
+			i += size
+			continue
+		}
+
+		cerr = cerr.record("encode", i, n, 0, r)
+		if o.encodeErr == nil {
+			break
+		}
+		dst = o.encodeErr(dst, c, r)
+		i += size
+	}
 
-func (c *codecMap8Bit) Encode(s string) (string, error) {
-	return mapRunesToBytes(c.EncodeMap, s)
+	return dst, cerr
 }
 
-func (c *codecMap8Bit) Decode(s string) (string, error) {
-	return mapBytesToRunes(c.DecodeMap, s)
+// NewSingleByteCodec returns a Codec for an 8bit encoding defined by a table
+// which maps every byte to a rune. Bytes mapped to utf8.RuneError are undefined.
+// If several bytes map to the same rune, the lowest one is used for encoding.
+func NewSingleByteCodec(table [256]rune) Codec {
+	return newCodecMap8Bit(&table, nil, nil)
 }
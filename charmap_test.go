@@ -1,11 +1,40 @@
 package charmap
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
 	"unicode/utf8"
 )
 
+// compiledIn reports whether the encoding is not excluded by build tags.
+func compiledIn(name string) bool {
+	_, err := Lookup(name)
+	return !errors.Is(err, ErrUnknownEncoding)
+}
+
+// requireEncodings skips the test if one of the encodings is excluded by build tags.
+func requireEncodings(t *testing.T, names ...string) {
+	t.Helper()
+	for _, name := range names {
+		if !compiledIn(name) {
+			t.Skipf("%s is excluded by build tags", name)
+		}
+	}
+}
+
 func TestEncode(t *testing.T) {
+	requireEncodings(t, "cp1251", "koi8-r")
+
 	pana_cp1251 := "\xC2\x20\xF7\xE0\xF9\xE0\xF5\x20\xFE\xE3\xE0\x20\xE6\xE8\xEB\x20\xE1\xFB\x20\xF6\xE8\xF2\xF0\xF3\xF1\x3F\x20\xC4\xE0\x2C\x20\xED\xEE\x20\xF4\xE0\xEB\xFC\xF8\xE8\xE2\xFB\xE9\x20\xFD\xEA\xE7\xE5\xEC\xEF\xEB\xFF\xF0\x21"
 	pana_koi8r := "\xF7\x20\xDE\xC1\xDD\xC1\xC8\x20\xC0\xC7\xC1\x20\xD6\xC9\xCC\x20\xC2\xD9\x20\xC3\xC9\xD4\xD2\xD5\xD3\x3F\x20\xE4\xC1\x2C\x20\xCE\xCF\x20\xC6\xC1\xCC\xD8\xDB\xC9\xD7\xD9\xCA\x20\xDC\xCB\xDA\xC5\xCD\xD0\xCC\xD1\xD2\x21"
 	pana_utf8 := "В чащах юга жил бы цитрус? Да, но фальшивый экземпляр!"
@@ -43,7 +72,7 @@ func TestEncode(t *testing.T) {
 	}
 
 	test_illegal, err := Encode("AαZ", "cp1251")
-	if err != ErrInvalidCodepoint {
+	if !errors.Is(err, ErrInvalidCodepoint) {
 		t.Error("encoding illegal codepoint: wrong error value")
 	}
 	if test_illegal != "A?Z" {
@@ -60,6 +89,8 @@ func TestEncode(t *testing.T) {
 }
 
 func TestDecode(t *testing.T) {
+	requireEncodings(t, "cp1251", "koi8-r")
+
 	pana_cp1251 := "\xC2\x20\xF7\xE0\xF9\xE0\xF5\x20\xFE\xE3\xE0\x20\xE6\xE8\xEB\x20\xE1\xFB\x20\xF6\xE8\xF2\xF0\xF3\xF1\x3F\x20\xC4\xE0\x2C\x20\xED\xEE\x20\xF4\xE0\xEB\xFC\xF8\xE8\xE2\xFB\xE9\x20\xFD\xEA\xE7\xE5\xEC\xEF\xEB\xFF\xF0\x21"
 	pana_koi8r := "\xF7\x20\xDE\xC1\xDD\xC1\xC8\x20\xC0\xC7\xC1\x20\xD6\xC9\xCC\x20\xC2\xD9\x20\xC3\xC9\xD4\xD2\xD5\xD3\x3F\x20\xE4\xC1\x2C\x20\xCE\xCF\x20\xC6\xC1\xCC\xD8\xDB\xC9\xD7\xD9\xCA\x20\xDC\xCB\xDA\xC5\xCD\xD0\xCC\xD1\xD2\x21"
 	pana_utf8 := "В чащах юга жил бы цитрус? Да, но фальшивый экземпляр!"
@@ -97,7 +128,7 @@ func TestDecode(t *testing.T) {
 	}
 
 	test_illegal, err := Decode("A\x98Z", "cp1251")
-	if err != ErrInvalidCodepoint {
+	if !errors.Is(err, ErrInvalidCodepoint) {
 		t.Error("decoding illegal codepoint: wrong error value")
 	}
 	if test_illegal != "A"+string(utf8.RuneError)+"Z" {
@@ -119,13 +150,327 @@ func TestList(t *testing.T) {
 		t.Error("list encoding: length == 0")
 	}
 
+	want := 0
+	for _, enc := range []string{"ISO-8859-5", "CP1251", "CP866", "KOI8-R"} {
+		if compiledIn(enc) {
+			want++
+		}
+	}
 	check := 0
 	for _, enc := range list {
 		if enc == "ISO-8859-5" || enc == "CP1251" || enc == "CP866" || enc == "KOI8-R" {
 			check++
 		}
 	}
-	if check != 4 {
+	if check != want {
 		t.Error("list encoding: encodings not found in list")
 	}
+	if !sort.StringsAreSorted(list) {
+		t.Error("list encoding: list is not sorted")
+	}
+}
+
+func TestEncodeBytes(t *testing.T) {
+	requireEncodings(t, "cp1251")
+
+	pana_cp1251 := []byte("\xC2\x20\xF7\xE0\xF9\xE0\xF5\x20\xFE\xE3\xE0")
+	pana_utf8 := []byte("В чащах юга")
+
+	test_cp1251, err := EncodeBytes(pana_utf8, "cp1251")
+	if err != nil {
+		t.Error("encoding bytes to cp1251: wrong error value")
+	}
+	if !bytes.Equal(test_cp1251, pana_cp1251) {
+		t.Error("encoding bytes to cp1251: wrong result")
+	}
+
+	test_errUnEnc, err := EncodeBytes(pana_utf8, "wrong-encoding")
+	if err != ErrUnknownEncoding {
+		t.Error("encoding bytes to wrong-encoding: wrong error value")
+	}
+	if !bytes.Equal(test_errUnEnc, pana_utf8) {
+		t.Error("encoding bytes to wrong-encoding: wrong result")
+	}
+
+	test_illegal, err := EncodeBytes([]byte("AαZ"), "cp1251")
+	if !errors.Is(err, ErrInvalidCodepoint) {
+		t.Error("encoding bytes illegal codepoint: wrong error value")
+	}
+	if string(test_illegal) != "A?Z" {
+		t.Error("encoding bytes illegal codepoint: wrong result")
+	}
+}
+
+func TestDecodeBytes(t *testing.T) {
+	requireEncodings(t, "cp1251")
+
+	pana_cp1251 := []byte("\xC2\x20\xF7\xE0\xF9\xE0\xF5\x20\xFE\xE3\xE0")
+	pana_utf8 := []byte("В чащах юга")
+
+	test_cp1251, err := DecodeBytes(pana_cp1251, "cp1251")
+	if err != nil {
+		t.Error("decoding bytes from cp1251: wrong error value")
+	}
+	if !bytes.Equal(test_cp1251, pana_utf8) {
+		t.Error("decoding bytes from cp1251: wrong result")
+	}
+
+	test_illegal, err := DecodeBytes([]byte("A\x98Z"), "cp1251")
+	if !errors.Is(err, ErrInvalidCodepoint) {
+		t.Error("decoding bytes illegal codepoint: wrong error value")
+	}
+	if string(test_illegal) != "A"+string(utf8.RuneError)+"Z" {
+		t.Error("decoding bytes illegal codepoint: wrong result")
+	}
+}
+
+func TestAppend(t *testing.T) {
+	requireEncodings(t, "cp1251")
+
+	pana_cp1251 := []byte("\xC2\x20\xF7\xE0\xF9\xE0\xF5\x20\xFE\xE3\xE0")
+	pana_utf8 := []byte("В чащах юга")
+
+	test_decode, err := AppendDecode([]byte("> "), pana_cp1251, "cp1251")
+	if err != nil {
+		t.Error("appending decoded bytes: wrong error value")
+	}
+	if string(test_decode) != "> "+string(pana_utf8) {
+		t.Error("appending decoded bytes: wrong result")
+	}
+
+	test_encode, err := AppendEncode([]byte("> "), pana_utf8, "cp1251")
+	if err != nil {
+		t.Error("appending encoded bytes: wrong error value")
+	}
+	if string(test_encode) != "> "+string(pana_cp1251) {
+		t.Error("appending encoded bytes: wrong result")
+	}
+
+	test_errUnEnc, err := AppendEncode([]byte("> "), pana_utf8, "wrong-encoding")
+	if err != ErrUnknownEncoding {
+		t.Error("appending to wrong-encoding: wrong error value")
+	}
+	if string(test_errUnEnc) != "> "+string(pana_utf8) {
+		t.Error("appending to wrong-encoding: wrong result")
+	}
+
+	buf := make([]byte, 0, 64)
+	allocs := testing.AllocsPerRun(100, func() {
+		buf, _ = AppendDecode(buf[:0], pana_cp1251, "CP1251")
+		buf, _ = AppendEncode(buf[:0], pana_utf8, "CP1251")
+	})
+	if allocs != 0 {
+		t.Errorf("appending into preallocated buffer: %v allocations", allocs)
+	}
+}
+
+func TestRegister(t *testing.T) {
+	requireEncodings(t, "cp1251")
+
+	var table [256]rune
+	for i := range table {
+		table[i] = rune(i)
+	}
+	table['$'] = '¤'
+	table[0xFF] = utf8.RuneError
+
+	err := Register("test-866", NewSingleByteCodec(table), "test_866_alias")
+	if err != nil {
+		t.Fatal("registering test-866: wrong error value")
+	}
+
+	test_encode, err := Encode("1¤", "TEST-866-ALIAS")
+	if err != nil || test_encode != "1$" {
+		t.Error("encoding to registered codec: wrong result")
+	}
+
+	test_decode, err := Decode("1$\xFF", "test-866")
+	if !errors.Is(err, ErrInvalidCodepoint) || test_decode != "1¤"+string(utf8.RuneError) {
+		t.Error("decoding from registered codec: wrong result")
+	}
+
+	err = Register("test-866-other", NewSingleByteCodec(table), "windows-1251")
+	if !errors.Is(err, ErrAlreadyRegistered) {
+		t.Error("registering alias collision: wrong error value")
+	}
+	if _, err := Lookup("test-866-other"); !errors.Is(err, ErrUnknownEncoding) {
+		t.Error("registering alias collision: codec was registered")
+	}
+
+	err = Register("cp1251", NewSingleByteCodec(table))
+	if !errors.Is(err, ErrAlreadyRegistered) {
+		t.Error("registering name collision: wrong error value")
+	}
+
+	for _, test := range [][]string{{""}, {" \t"}, {"-_-"}, {"test-866-empty", " "}} {
+		if err := Register(test[0], NewSingleByteCodec(table), test[1:]...); err == nil {
+			t.Errorf("registering empty name %q: no error", test)
+		}
+	}
+	if _, err := Lookup(""); err == nil {
+		t.Error("looking up empty name: no error")
+	}
+	if _, err := Lookup("test-866-empty"); err == nil {
+		t.Error("registering empty alias: codec was registered")
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			Register(fmt.Sprintf("test-concurrent-%d", i), NewSingleByteCodec(table))
+			Encode("test", "cp1251")
+			List()
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestConversionError(t *testing.T) {
+	requireEncodings(t, "cp1251")
+
+	_, err := Encode("Да, αβ!", "cp1251")
+	cerr, ok := err.(*ConversionError)
+	if !ok {
+		t.Fatal("encoding illegal codepoints: wrong error type")
+	}
+	if cerr.Encoding != "CP1251" || cerr.Op != "encode" || cerr.Rune != 'α' || cerr.Count != 2 {
+		t.Error("encoding illegal codepoints: wrong error fields")
+	}
+	if cerr.Offset != 6 || cerr.RuneOffset != 4 {
+		t.Error("encoding illegal codepoints: wrong error offsets")
+	}
+
+	_, err = DecodeBytes([]byte("AB\x98C\x98"), "windows-1251")
+	cerr, ok = err.(*ConversionError)
+	if !ok {
+		t.Fatal("decoding illegal codepoints: wrong error type")
+	}
+	if cerr.Encoding != "CP1251" || cerr.Op != "decode" || cerr.Byte != 0x98 || cerr.Offset != 2 || cerr.Count != 2 {
+		t.Error("decoding illegal codepoints: wrong error fields")
+	}
+	if cerr.Error() != "cannot decode byte 0x98 from CP1251 at offset 2 (and 1 more)" {
+		t.Error("decoding illegal codepoints: wrong error message")
+	}
+}
+
+func TestASCIIRuns(t *testing.T) {
+	requireEncodings(t, "cp1251")
+
+	long := strings.Repeat("The quick brown fox ", 3)
+
+	test_str, err := Encode("Да, "+long+"α"+long, "cp1251")
+	cerr, ok := err.(*ConversionError)
+	if !ok || cerr.Offset != int64(6+len(long)) || cerr.RuneOffset != int64(4+len(long)) {
+		t.Error("encoding long ascii runs: wrong error value")
+	}
+	if test_str != "\xc4\xe0, "+long+"?"+long {
+		t.Error("encoding long ascii runs: wrong result")
+	}
+
+	test_str, err = Decode(long+"\x98"+long+"\xC0", "cp1251")
+	cerr, ok = err.(*ConversionError)
+	if !ok || cerr.Offset != int64(len(long)) || cerr.Count != 1 {
+		t.Error("decoding long ascii runs: wrong error value")
+	}
+	if test_str != long+string(utf8.RuneError)+long+"А" {
+		t.Error("decoding long ascii runs: wrong result")
+	}
+
+	// CP864 maps '%' to ARABIC PERCENT SIGN, so ASCII is not copied as is
+	if !compiledIn("cp864") {
+		return
+	}
+	test_str, err = Decode("50%, 100% and more than 100%", "cp864")
+	if err != nil || test_str != "50٪, 100٪ and more than 100٪" {
+		t.Error("decoding cp864 ascii: wrong result")
+	}
+}
+
+// TestImportCost runs the test binary with GODEBUG=inittrace=1 and checks that
+// initializing the package does not build the codec tables.
+func TestImportCost(t *testing.T) {
+	cmd := exec.Command(os.Args[0], "-test.run=^$")
+	cmd.Env = append(os.Environ(), "GODEBUG=inittrace=1")
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("running %s: %v\n%s", os.Args[0], err, out)
+	}
+
+	pkg := reflect.TypeOf(Encoding{}).PkgPath()
+	re := regexp.MustCompile(`(?m)^init ` + regexp.QuoteMeta(pkg) + ` @.*, (\d+) bytes, (\d+) allocs$`)
+	m := re.FindSubmatch(out)
+	if m == nil {
+		t.Fatalf("no init trace for %s in output:\n%s", pkg, out)
+	}
+
+	bytes, _ := strconv.Atoi(string(m[1]))
+	allocs, _ := strconv.Atoi(string(m[2]))
+	if bytes > 4096 || allocs > 16 {
+		t.Errorf("package init: %d bytes, %d allocs", bytes, allocs)
+	}
+}
+
+func TestLazyCodec(t *testing.T) {
+	// the last compiled builtin, which other tests are unlikely to have used
+	var b *builtin
+	for i := range builtins {
+		if builtins[i].table != nil {
+			b = &builtins[i]
+		}
+	}
+
+	loadRegistry()
+	registryMu.RLock()
+	e := codecsMap[b.name]
+	registryMu.RUnlock()
+
+	if e == nil || e.builtin == nil {
+		t.Fatal("lazy codec: builtin encoding without table")
+	}
+	if e2, _ := Lookup(b.aliases[0]); e2 != e || e.Codec() == nil {
+		t.Error("lazy codec: codec is not built by Lookup")
+	}
+}
+
+func TestUnknownEncoding(t *testing.T) {
+	requireEncodings(t, "cp1251", "cp866", "iso-8859-1")
+
+	_, err := Encode("test", "cp1521")
+	uerr, ok := err.(*UnknownEncodingError)
+	if !ok || !errors.Is(err, ErrUnknownEncoding) {
+		t.Fatal("encoding to cp1521: wrong error value")
+	}
+	if uerr.Name != "cp1521" || len(uerr.Suggestions) == 0 || uerr.Suggestions[0] != "CP1251" {
+		t.Error("encoding to cp1521: wrong suggestions")
+	}
+
+	_, err = Decode("test", "latin-l")
+	if uerr, ok := err.(*UnknownEncodingError); !ok || uerr.Suggestions[0] != "ISO-8859-1" {
+		t.Error("decoding from latin-l: wrong suggestions")
+	}
+
+	_, err = Decode("test", "dos-866")
+	if uerr, ok := err.(*UnknownEncodingError); !ok || uerr.Suggestions[0] != "CP866" || strings.Contains(err.Error(), "CP874") {
+		t.Errorf("decoding from dos-866: wrong suggestions in %v", err)
+	}
+
+	// ISO-IR-6 is ASCII, not one of the ISO-IR-1x6 encodings
+	_, err = Decode("test", "iso-ir-6")
+	if err != ErrUnknownEncoding {
+		t.Errorf("decoding from iso-ir-6: wrong error value %v", err)
+	}
+
+	_, err = Decode("test", "wrong-encoding")
+	if err != ErrUnknownEncoding {
+		t.Error("decoding from wrong-encoding: wrong error value")
+	}
+
+	if d := editDistance("CP1521", "CP1251"); d != 1 {
+		t.Errorf("edit distance of a transposition: %d", d)
+	}
+	if d := editDistance("KOI8", "KOI8R"); d != 1 {
+		t.Errorf("edit distance of an insertion: %d", d)
+	}
 }
@@ -0,0 +1,238 @@
+package charmap
+
+import (
+	"bufio"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+var ErrMultiByteCharmap error = errors.New("charmap defines multi-byte characters")
+
+// ParseCharmap reads a character set description in the POSIX charmap format used by
+// glibc (/usr/share/i18n/charmaps) and returns its code set name, the aliases declared
+// in "% alias" lines and a Codec for it.
+// Only single-byte charmaps are supported, for others ErrMultiByteCharmap is returned.
+func ParseCharmap(r io.Reader) (name string, aliases []string, c Codec, err error) {
+	var table [256]rune
+	for i := range table {
+		table[i] = utf8.RuneError
+	}
+
+	commentChar, escapeChar := byte('#'), byte('\\')
+	inCharmap := false
+
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+
+		if text[0] == commentChar {
+			fields := strings.Fields(text[1:])
+			if len(fields) == 2 && fields[0] == "alias" {
+				aliases = append(aliases, fields[1])
+			}
+			continue
+		}
+
+		fields := strings.Fields(text)
+		if !inCharmap {
+			switch {
+			case fields[0] == "CHARMAP":
+				inCharmap = true
+			case len(fields) < 2:
+				// unknown keyword
+			case fields[0] == "<code_set_name>":
+				name = fields[1]
+			case fields[0] == "<comment_char>":
+				commentChar = fields[1][0]
+			case fields[0] == "<escape_char>":
+				escapeChar = fields[1][0]
+			case fields[0] == "<mb_cur_max>":
+				if fields[1] != "1" {
+					return name, aliases, nil, ErrMultiByteCharmap
+				}
+			}
+			continue
+		}
+
+		if fields[0] == "END" {
+			break
+		}
+		if len(fields) < 2 {
+			return name, aliases, nil, fmt.Errorf("charmap: charmap line %d: missing byte sequence", line)
+		}
+
+		first, last, ok := parseCharmapSymbol(fields[0])
+		if !ok {
+			// symbolic names without a code point cannot be mapped
+			continue
+		}
+
+		seq, err := parseCharmapBytes(fields[1], escapeChar)
+		if err != nil {
+			return name, aliases, nil, fmt.Errorf("charmap: charmap line %d: %v", line, err)
+		}
+		if len(seq) != 1 {
+			return name, aliases, nil, ErrMultiByteCharmap
+		}
+
+		for r, b := first, int(seq[0]); r <= last; r, b = r+1, b+1 {
+			if b > 0xFF {
+				return name, aliases, nil, fmt.Errorf("charmap: charmap line %d: range exceeds byte values", line)
+			}
+			if table[b] == utf8.RuneError {
+				table[b] = r
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return name, aliases, nil, err
+	}
+
+	if name == "" {
+		return name, aliases, nil, errors.New("charmap: charmap has no <code_set_name>")
+	}
+	if !inCharmap {
+		return name, aliases, nil, errors.New("charmap: charmap has no CHARMAP section")
+	}
+
+	return name, aliases, NewSingleByteCodec(table), nil
+}
+
+// parseCharmapSymbol parses a symbol of the form <Uxxxx> or a range <Uxxxx>..<Uyyyy>.
+func parseCharmapSymbol(s string) (first, last rune, ok bool) {
+	from, to := s, s
+	if i := strings.Index(s, ".."); i >= 0 {
+		from, to = s[:i], s[i+2:]
+	}
+
+	first, ok = parseUnicodeSymbol(from)
+	if !ok {
+		return 0, 0, false
+	}
+	last, ok = parseUnicodeSymbol(to)
+	if !ok || last < first {
+		return 0, 0, false
+	}
+	return first, last, true
+}
+
+func parseUnicodeSymbol(s string) (rune, bool) {
+	if len(s) < 4 || s[0] != '<' || s[1] != 'U' || s[len(s)-1] != '>' {
+		return 0, false
+	}
+
+	n, err := strconv.ParseUint(s[2:len(s)-1], 16, 32)
+	if err != nil || !utf8.ValidRune(rune(n)) {
+		return 0, false
+	}
+	return rune(n), true
+}
+
+// parseCharmapBytes parses a byte sequence such as /xd0/x90, /d208 or /o320.
+func parseCharmapBytes(s string, escapeChar byte) ([]byte, error) {
+	var seq []byte
+
+	for len(s) > 0 {
+		if len(s) < 3 || s[0] != escapeChar {
+			return nil, fmt.Errorf("invalid byte sequence %q", s)
+		}
+
+		base := 0
+		switch s[1] {
+		case 'x':
+			base = 16
+		case 'd':
+			base = 10
+		case 'o':
+			base = 8
+		default:
+			return nil, fmt.Errorf("invalid byte sequence %q", s)
+		}
+
+		end := 2
+		for end < len(s) && s[end] != escapeChar {
+			end++
+		}
+		n, err := strconv.ParseUint(s[2:end], base, 8)
+		if err != nil {
+			return nil, fmt.Errorf("invalid byte value %q", s[:end])
+		}
+
+		seq = append(seq, byte(n))
+		s = s[end:]
+	}
+
+	return seq, nil
+}
+
+// LoadCharmapFile parses a charmap file, which may be gzip-compressed, and registers
+// it under its code set name together with the declared aliases.
+// Aliases which are already used by other encodings are left out.
+// It returns the name of the registered encoding.
+func LoadCharmapFile(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	var r io.Reader = bufio.NewReader(f)
+	if magic, _ := r.(*bufio.Reader).Peek(2); len(magic) == 2 && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return "", err
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	name, aliases, c, err := ParseCharmap(r)
+	if err != nil {
+		return name, err
+	}
+
+	if err := registerChecked(c, name, aliases, true); err != nil {
+		return name, err
+	}
+	return normalizeName(name), nil
+}
+
+// LoadCharmapDir loads every charmap file in dir with LoadCharmapFile, e.g.
+// LoadCharmapDir("/usr/share/i18n/charmaps"). It returns the names of the registered
+// encodings and the files which were skipped, with the reason: ErrMultiByteCharmap,
+// ErrAlreadyRegistered for encodings which are supported already, or a parse error.
+func LoadCharmapDir(dir string) (loaded []string, skipped map[string]error, err error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	skipped = make(map[string]error)
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+
+		name, err := LoadCharmapFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			skipped[entry.Name()] = err
+			continue
+		}
+		loaded = append(loaded, name)
+	}
+
+	return loaded, skipped, nil
+}
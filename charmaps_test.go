@@ -0,0 +1,104 @@
+package charmap
+
+import (
+	"compress/gzip"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testCharmap = `<code_set_name> TEST-CYRILLIC
+<comment_char> %
+<escape_char> /
+<mb_cur_min> 1
+<mb_cur_max> 1
+% source: test
+% alias TEST_CYR
+% alias WINDOWS-1251
+CHARMAP
+<U0000>..<U007F> /x00 <control and ASCII>
+<U0410>     /xc0         CYRILLIC CAPITAL LETTER A
+<U044F>     /d255        CYRILLIC SMALL LETTER YA
+END CHARMAP
+WIDTH
+<U0410> 1
+END WIDTH
+`
+
+const testCharmapMultiByte = `<code_set_name> TEST-UTF8
+<comment_char> %
+<escape_char> /
+<mb_cur_min> 1
+<mb_cur_max> 6
+CHARMAP
+<U0410>     /xd0/x90     CYRILLIC CAPITAL LETTER A
+END CHARMAP
+`
+
+func TestParseCharmap(t *testing.T) {
+	name, aliases, c, err := ParseCharmap(strings.NewReader(testCharmap))
+	if err != nil {
+		t.Fatal("parsing charmap: wrong error value")
+	}
+	if name != "TEST-CYRILLIC" || len(aliases) != 2 || aliases[0] != "TEST_CYR" {
+		t.Error("parsing charmap: wrong name or aliases")
+	}
+	if r, ok := c.DecodeByte('z'); !ok || r != 'z' {
+		t.Error("parsing charmap: wrong decoded range")
+	}
+	if b, ok := c.EncodeRune('я'); !ok || b != 0xFF {
+		t.Error("parsing charmap: wrong encoded byte")
+	}
+	if _, ok := c.DecodeByte(0xC1); ok {
+		t.Error("parsing charmap: undefined byte is defined")
+	}
+
+	_, _, _, err = ParseCharmap(strings.NewReader(testCharmapMultiByte))
+	if err != ErrMultiByteCharmap {
+		t.Error("parsing multi-byte charmap: wrong error value")
+	}
+}
+
+func TestLoadCharmapDir(t *testing.T) {
+	requireEncodings(t, "cp1251")
+
+	dir := t.TempDir()
+
+	f, err := os.Create(filepath.Join(dir, "TEST-CYRILLIC.gz"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	gz := gzip.NewWriter(f)
+	gz.Write([]byte(testCharmap))
+	gz.Close()
+	f.Close()
+
+	os.WriteFile(filepath.Join(dir, "TEST-UTF8"), []byte(testCharmapMultiByte), 0666)
+	os.WriteFile(filepath.Join(dir, "CP1251"), []byte(strings.Replace(testCharmap, "TEST-CYRILLIC", "CP1251", 1)), 0666)
+
+	loaded, skipped, err := LoadCharmapDir(dir)
+	if err != nil {
+		t.Fatal("loading charmaps: wrong error value")
+	}
+	if len(loaded) != 1 || loaded[0] != "TEST-CYRILLIC" {
+		t.Error("loading charmaps: wrong loaded list")
+	}
+	if len(skipped) != 2 || skipped["TEST-UTF8"] != ErrMultiByteCharmap || !errors.Is(skipped["CP1251"], ErrAlreadyRegistered) {
+		t.Error("loading charmaps: wrong skipped list")
+	}
+
+	test_decode, err := Decode("A\xC0\xFF", "test_cyr")
+	if err != nil || test_decode != "AАя" {
+		t.Error("decoding from loaded charmap: wrong result")
+	}
+
+	// the alias used by CP1251 is left out
+	if enc, _ := Lookup("windows-1251"); enc.Name() != "CP1251" {
+		t.Error("encoding to windows-1251: alias was overridden")
+	}
+	if enc, _ := Lookup("test-cyrillic"); len(enc.Aliases()) != 1 {
+		t.Error("loading charmaps: wrong aliases")
+	}
+}
@@ -1,272 +1,266 @@
+//go:build !charmap_no_windows
 
 package charmap
 
-func init() {
-
-	charmapDecode := map[byte]rune{
-		'\x00':	'\u0000',	 // NULL
-		'\x01':	'\u0001',	 // START OF HEADING
-		'\x02':	'\u0002',	 // START OF TEXT
-		'\x03':	'\u0003',	 // END OF TEXT
-		'\x04':	'\u0004',	 // END OF TRANSMISSION
-		'\x05':	'\u0005',	 // ENQUIRY
-		'\x06':	'\u0006',	 // ACKNOWLEDGE
-		'\x07':	'\u0007',	 // BELL
-		'\x08':	'\u0008',	 // BACKSPACE
-		'\x09':	'\u0009',	 // HORIZONTAL TABULATION
-		'\x0A':	'\u000A',	 // LINE FEED
-		'\x0B':	'\u000B',	 // VERTICAL TABULATION
-		'\x0C':	'\u000C',	 // FORM FEED
-		'\x0D':	'\u000D',	 // CARRIAGE RETURN
-		'\x0E':	'\u000E',	 // SHIFT OUT
-		'\x0F':	'\u000F',	 // SHIFT IN
-		'\x10':	'\u0010',	 // DATA LINK ESCAPE
-		'\x11':	'\u0011',	 // DEVICE CONTROL ONE
-		'\x12':	'\u0012',	 // DEVICE CONTROL TWO
-		'\x13':	'\u0013',	 // DEVICE CONTROL THREE
-		'\x14':	'\u0014',	 // DEVICE CONTROL FOUR
-		'\x15':	'\u0015',	 // NEGATIVE ACKNOWLEDGE
-		'\x16':	'\u0016',	 // SYNCHRONOUS IDLE
-		'\x17':	'\u0017',	 // END OF TRANSMISSION BLOCK
-		'\x18':	'\u0018',	 // CANCEL
-		'\x19':	'\u0019',	 // END OF MEDIUM
-		'\x1A':	'\u001A',	 // SUBSTITUTE
-		'\x1B':	'\u001B',	 // ESCAPE
-		'\x1C':	'\u001C',	 // FILE SEPARATOR
-		'\x1D':	'\u001D',	 // GROUP SEPARATOR
-		'\x1E':	'\u001E',	 // RECORD SEPARATOR
-		'\x1F':	'\u001F',	 // UNIT SEPARATOR
-		'\x20':	'\u0020',	 // SPACE
-		'\x21':	'\u0021',	 // EXCLAMATION MARK
-		'\x22':	'\u0022',	 // QUOTATION MARK
-		'\x23':	'\u0023',	 // NUMBER SIGN
-		'\x24':	'\u0024',	 // DOLLAR SIGN
-		'\x25':	'\u0025',	 // PERCENT SIGN
-		'\x26':	'\u0026',	 // AMPERSAND
-		'\x27':	'\u0027',	 // APOSTROPHE
-		'\x28':	'\u0028',	 // LEFT PARENTHESIS
-		'\x29':	'\u0029',	 // RIGHT PARENTHESIS
-		'\x2A':	'\u002A',	 // ASTERISK
-		'\x2B':	'\u002B',	 // PLUS SIGN
-		'\x2C':	'\u002C',	 // COMMA
-		'\x2D':	'\u002D',	 // HYPHEN-MINUS
-		'\x2E':	'\u002E',	 // FULL STOP
-		'\x2F':	'\u002F',	 // SOLIDUS
-		'\x30':	'\u0030',	 // DIGIT ZERO
-		'\x31':	'\u0031',	 // DIGIT ONE
-		'\x32':	'\u0032',	 // DIGIT TWO
-		'\x33':	'\u0033',	 // DIGIT THREE
-		'\x34':	'\u0034',	 // DIGIT FOUR
-		'\x35':	'\u0035',	 // DIGIT FIVE
-		'\x36':	'\u0036',	 // DIGIT SIX
-		'\x37':	'\u0037',	 // DIGIT SEVEN
-		'\x38':	'\u0038',	 // DIGIT EIGHT
-		'\x39':	'\u0039',	 // DIGIT NINE
-		'\x3A':	'\u003A',	 // COLON
-		'\x3B':	'\u003B',	 // SEMICOLON
-		'\x3C':	'\u003C',	 // LESS-THAN SIGN
-		'\x3D':	'\u003D',	 // EQUALS SIGN
-		'\x3E':	'\u003E',	 // GREATER-THAN SIGN
-		'\x3F':	'\u003F',	 // QUESTION MARK
-		'\x40':	'\u0040',	 // COMMERCIAL AT
-		'\x41':	'\u0041',	 // LATIN CAPITAL LETTER A
-		'\x42':	'\u0042',	 // LATIN CAPITAL LETTER B
-		'\x43':	'\u0043',	 // LATIN CAPITAL LETTER C
-		'\x44':	'\u0044',	 // LATIN CAPITAL LETTER D
-		'\x45':	'\u0045',	 // LATIN CAPITAL LETTER E
-		'\x46':	'\u0046',	 // LATIN CAPITAL LETTER F
-		'\x47':	'\u0047',	 // LATIN CAPITAL LETTER G
-		'\x48':	'\u0048',	 // LATIN CAPITAL LETTER H
-		'\x49':	'\u0049',	 // LATIN CAPITAL LETTER I
-		'\x4A':	'\u004A',	 // LATIN CAPITAL LETTER J
-		'\x4B':	'\u004B',	 // LATIN CAPITAL LETTER K
-		'\x4C':	'\u004C',	 // LATIN CAPITAL LETTER L
-		'\x4D':	'\u004D',	 // LATIN CAPITAL LETTER M
-		'\x4E':	'\u004E',	 // LATIN CAPITAL LETTER N
-		'\x4F':	'\u004F',	 // LATIN CAPITAL LETTER O
-		'\x50':	'\u0050',	 // LATIN CAPITAL LETTER P
-		'\x51':	'\u0051',	 // LATIN CAPITAL LETTER Q
-		'\x52':	'\u0052',	 // LATIN CAPITAL LETTER R
-		'\x53':	'\u0053',	 // LATIN CAPITAL LETTER S
-		'\x54':	'\u0054',	 // LATIN CAPITAL LETTER T
-		'\x55':	'\u0055',	 // LATIN CAPITAL LETTER U
-		'\x56':	'\u0056',	 // LATIN CAPITAL LETTER V
-		'\x57':	'\u0057',	 // LATIN CAPITAL LETTER W
-		'\x58':	'\u0058',	 // LATIN CAPITAL LETTER X
-		'\x59':	'\u0059',	 // LATIN CAPITAL LETTER Y
-		'\x5A':	'\u005A',	 // LATIN CAPITAL LETTER Z
-		'\x5B':	'\u005B',	 // LEFT SQUARE BRACKET
-		'\x5C':	'\u005C',	 // REVERSE SOLIDUS
-		'\x5D':	'\u005D',	 // RIGHT SQUARE BRACKET
-		'\x5E':	'\u005E',	 // CIRCUMFLEX ACCENT
-		'\x5F':	'\u005F',	 // LOW LINE
-		'\x60':	'\u0060',	 // GRAVE ACCENT
-		'\x61':	'\u0061',	 // LATIN SMALL LETTER A
-		'\x62':	'\u0062',	 // LATIN SMALL LETTER B
-		'\x63':	'\u0063',	 // LATIN SMALL LETTER C
-		'\x64':	'\u0064',	 // LATIN SMALL LETTER D
-		'\x65':	'\u0065',	 // LATIN SMALL LETTER E
-		'\x66':	'\u0066',	 // LATIN SMALL LETTER F
-		'\x67':	'\u0067',	 // LATIN SMALL LETTER G
-		'\x68':	'\u0068',	 // LATIN SMALL LETTER H
-		'\x69':	'\u0069',	 // LATIN SMALL LETTER I
-		'\x6A':	'\u006A',	 // LATIN SMALL LETTER J
-		'\x6B':	'\u006B',	 // LATIN SMALL LETTER K
-		'\x6C':	'\u006C',	 // LATIN SMALL LETTER L
-		'\x6D':	'\u006D',	 // LATIN SMALL LETTER M
-		'\x6E':	'\u006E',	 // LATIN SMALL LETTER N
-		'\x6F':	'\u006F',	 // LATIN SMALL LETTER O
-		'\x70':	'\u0070',	 // LATIN SMALL LETTER P
-		'\x71':	'\u0071',	 // LATIN SMALL LETTER Q
-		'\x72':	'\u0072',	 // LATIN SMALL LETTER R
-		'\x73':	'\u0073',	 // LATIN SMALL LETTER S
-		'\x74':	'\u0074',	 // LATIN SMALL LETTER T
-		'\x75':	'\u0075',	 // LATIN SMALL LETTER U
-		'\x76':	'\u0076',	 // LATIN SMALL LETTER V
-		'\x77':	'\u0077',	 // LATIN SMALL LETTER W
-		'\x78':	'\u0078',	 // LATIN SMALL LETTER X
-		'\x79':	'\u0079',	 // LATIN SMALL LETTER Y
-		'\x7A':	'\u007A',	 // LATIN SMALL LETTER Z
-		'\x7B':	'\u007B',	 // LEFT CURLY BRACKET
-		'\x7C':	'\u007C',	 // VERTICAL LINE
-		'\x7D':	'\u007D',	 // RIGHT CURLY BRACKET
-		'\x7E':	'\u007E',	 // TILDE
-		'\x7F':	'\u007F',	 // DELETE
-		'\x80':	'\u0402',	 // CYRILLIC CAPITAL LETTER DJE
-		'\x81':	'\u0403',	 // CYRILLIC CAPITAL LETTER GJE
-		'\x82':	'\u201A',	 // SINGLE LOW-9 QUOTATION MARK
-		'\x83':	'\u0453',	 // CYRILLIC SMALL LETTER GJE
-		'\x84':	'\u201E',	 // DOUBLE LOW-9 QUOTATION MARK
-		'\x85':	'\u2026',	 // HORIZONTAL ELLIPSIS
-		'\x86':	'\u2020',	 // DAGGER
-		'\x87':	'\u2021',	 // DOUBLE DAGGER
-		'\x88':	'\u20AC',	 // EURO SIGN
-		'\x89':	'\u2030',	 // PER MILLE SIGN
-		'\x8A':	'\u0409',	 // CYRILLIC CAPITAL LETTER LJE
-		'\x8B':	'\u2039',	 // SINGLE LEFT-POINTING ANGLE QUOTATION MARK
-		'\x8C':	'\u040A',	 // CYRILLIC CAPITAL LETTER NJE
-		'\x8D':	'\u040C',	 // CYRILLIC CAPITAL LETTER KJE
-		'\x8E':	'\u040B',	 // CYRILLIC CAPITAL LETTER TSHE
-		'\x8F':	'\u040F',	 // CYRILLIC CAPITAL LETTER DZHE
-		'\x90':	'\u0452',	 // CYRILLIC SMALL LETTER DJE
-		'\x91':	'\u2018',	 // LEFT SINGLE QUOTATION MARK
-		'\x92':	'\u2019',	 // RIGHT SINGLE QUOTATION MARK
-		'\x93':	'\u201C',	 // LEFT DOUBLE QUOTATION MARK
-		'\x94':	'\u201D',	 // RIGHT DOUBLE QUOTATION MARK
-		'\x95':	'\u2022',	 // BULLET
-		'\x96':	'\u2013',	 // EN DASH
-		'\x97':	'\u2014',	 // EM DASH
-		// '\x98' UNDEFINED
-		'\x99':	'\u2122',	 // TRADE MARK SIGN
-		'\x9A':	'\u0459',	 // CYRILLIC SMALL LETTER LJE
-		'\x9B':	'\u203A',	 // SINGLE RIGHT-POINTING ANGLE QUOTATION MARK
-		'\x9C':	'\u045A',	 // CYRILLIC SMALL LETTER NJE
-		'\x9D':	'\u045C',	 // CYRILLIC SMALL LETTER KJE
-		'\x9E':	'\u045B',	 // CYRILLIC SMALL LETTER TSHE
-		'\x9F':	'\u045F',	 // CYRILLIC SMALL LETTER DZHE
-		'\xA0':	'\u00A0',	 // NO-BREAK SPACE
-		'\xA1':	'\u040E',	 // CYRILLIC CAPITAL LETTER SHORT U
-		'\xA2':	'\u045E',	 // CYRILLIC SMALL LETTER SHORT U
-		'\xA3':	'\u0408',	 // CYRILLIC CAPITAL LETTER JE
-		'\xA4':	'\u00A4',	 // CURRENCY SIGN
-		'\xA5':	'\u0490',	 // CYRILLIC CAPITAL LETTER GHE WITH UPTURN
-		'\xA6':	'\u00A6',	 // BROKEN BAR
-		'\xA7':	'\u00A7',	 // SECTION SIGN
-		'\xA8':	'\u0401',	 // CYRILLIC CAPITAL LETTER IO
-		'\xA9':	'\u00A9',	 // COPYRIGHT SIGN
-		'\xAA':	'\u0404',	 // CYRILLIC CAPITAL LETTER UKRAINIAN IE
-		'\xAB':	'\u00AB',	 // LEFT-POINTING DOUBLE ANGLE QUOTATION MARK
-		'\xAC':	'\u00AC',	 // NOT SIGN
-		'\xAD':	'\u00AD',	 // SOFT HYPHEN
-		'\xAE':	'\u00AE',	 // REGISTERED SIGN
-		'\xAF':	'\u0407',	 // CYRILLIC CAPITAL LETTER YI
-		'\xB0':	'\u00B0',	 // DEGREE SIGN
-		'\xB1':	'\u00B1',	 // PLUS-MINUS SIGN
-		'\xB2':	'\u0406',	 // CYRILLIC CAPITAL LETTER BYELORUSSIAN-UKRAINIAN I
-		'\xB3':	'\u0456',	 // CYRILLIC SMALL LETTER BYELORUSSIAN-UKRAINIAN I
-		'\xB4':	'\u0491',	 // CYRILLIC SMALL LETTER GHE WITH UPTURN
-		'\xB5':	'\u00B5',	 // MICRO SIGN
-		'\xB6':	'\u00B6',	 // PILCROW SIGN
-		'\xB7':	'\u00B7',	 // MIDDLE DOT
-		'\xB8':	'\u0451',	 // CYRILLIC SMALL LETTER IO
-		'\xB9':	'\u2116',	 // NUMERO SIGN
-		'\xBA':	'\u0454',	 // CYRILLIC SMALL LETTER UKRAINIAN IE
-		'\xBB':	'\u00BB',	 // RIGHT-POINTING DOUBLE ANGLE QUOTATION MARK
-		'\xBC':	'\u0458',	 // CYRILLIC SMALL LETTER JE
-		'\xBD':	'\u0405',	 // CYRILLIC CAPITAL LETTER DZE
-		'\xBE':	'\u0455',	 // CYRILLIC SMALL LETTER DZE
-		'\xBF':	'\u0457',	 // CYRILLIC SMALL LETTER YI
-		'\xC0':	'\u0410',	 // CYRILLIC CAPITAL LETTER A
-		'\xC1':	'\u0411',	 // CYRILLIC CAPITAL LETTER BE
-		'\xC2':	'\u0412',	 // CYRILLIC CAPITAL LETTER VE
-		'\xC3':	'\u0413',	 // CYRILLIC CAPITAL LETTER GHE
-		'\xC4':	'\u0414',	 // CYRILLIC CAPITAL LETTER DE
-		'\xC5':	'\u0415',	 // CYRILLIC CAPITAL LETTER IE
-		'\xC6':	'\u0416',	 // CYRILLIC CAPITAL LETTER ZHE
-		'\xC7':	'\u0417',	 // CYRILLIC CAPITAL LETTER ZE
-		'\xC8':	'\u0418',	 // CYRILLIC CAPITAL LETTER I
-		'\xC9':	'\u0419',	 // CYRILLIC CAPITAL LETTER SHORT I
-		'\xCA':	'\u041A',	 // CYRILLIC CAPITAL LETTER KA
-		'\xCB':	'\u041B',	 // CYRILLIC CAPITAL LETTER EL
-		'\xCC':	'\u041C',	 // CYRILLIC CAPITAL LETTER EM
-		'\xCD':	'\u041D',	 // CYRILLIC CAPITAL LETTER EN
-		'\xCE':	'\u041E',	 // CYRILLIC CAPITAL LETTER O
-		'\xCF':	'\u041F',	 // CYRILLIC CAPITAL LETTER PE
-		'\xD0':	'\u0420',	 // CYRILLIC CAPITAL LETTER ER
-		'\xD1':	'\u0421',	 // CYRILLIC CAPITAL LETTER ES
-		'\xD2':	'\u0422',	 // CYRILLIC CAPITAL LETTER TE
-		'\xD3':	'\u0423',	 // CYRILLIC CAPITAL LETTER U
-		'\xD4':	'\u0424',	 // CYRILLIC CAPITAL LETTER EF
-		'\xD5':	'\u0425',	 // CYRILLIC CAPITAL LETTER HA
-		'\xD6':	'\u0426',	 // CYRILLIC CAPITAL LETTER TSE
-		'\xD7':	'\u0427',	 // CYRILLIC CAPITAL LETTER CHE
-		'\xD8':	'\u0428',	 // CYRILLIC CAPITAL LETTER SHA
-		'\xD9':	'\u0429',	 // CYRILLIC CAPITAL LETTER SHCHA
-		'\xDA':	'\u042A',	 // CYRILLIC CAPITAL LETTER HARD SIGN
-		'\xDB':	'\u042B',	 // CYRILLIC CAPITAL LETTER YERU
-		'\xDC':	'\u042C',	 // CYRILLIC CAPITAL LETTER SOFT SIGN
-		'\xDD':	'\u042D',	 // CYRILLIC CAPITAL LETTER E
-		'\xDE':	'\u042E',	 // CYRILLIC CAPITAL LETTER YU
-		'\xDF':	'\u042F',	 // CYRILLIC CAPITAL LETTER YA
-		'\xE0':	'\u0430',	 // CYRILLIC SMALL LETTER A
-		'\xE1':	'\u0431',	 // CYRILLIC SMALL LETTER BE
-		'\xE2':	'\u0432',	 // CYRILLIC SMALL LETTER VE
-		'\xE3':	'\u0433',	 // CYRILLIC SMALL LETTER GHE
-		'\xE4':	'\u0434',	 // CYRILLIC SMALL LETTER DE
-		'\xE5':	'\u0435',	 // CYRILLIC SMALL LETTER IE
-		'\xE6':	'\u0436',	 // CYRILLIC SMALL LETTER ZHE
-		'\xE7':	'\u0437',	 // CYRILLIC SMALL LETTER ZE
-		'\xE8':	'\u0438',	 // CYRILLIC SMALL LETTER I
-		'\xE9':	'\u0439',	 // CYRILLIC SMALL LETTER SHORT I
-		'\xEA':	'\u043A',	 // CYRILLIC SMALL LETTER KA
-		'\xEB':	'\u043B',	 // CYRILLIC SMALL LETTER EL
-		'\xEC':	'\u043C',	 // CYRILLIC SMALL LETTER EM
-		'\xED':	'\u043D',	 // CYRILLIC SMALL LETTER EN
-		'\xEE':	'\u043E',	 // CYRILLIC SMALL LETTER O
-		'\xEF':	'\u043F',	 // CYRILLIC SMALL LETTER PE
-		'\xF0':	'\u0440',	 // CYRILLIC SMALL LETTER ER
-		'\xF1':	'\u0441',	 // CYRILLIC SMALL LETTER ES
-		'\xF2':	'\u0442',	 // CYRILLIC SMALL LETTER TE
-		'\xF3':	'\u0443',	 // CYRILLIC SMALL LETTER U
-		'\xF4':	'\u0444',	 // CYRILLIC SMALL LETTER EF
-		'\xF5':	'\u0445',	 // CYRILLIC SMALL LETTER HA
-		'\xF6':	'\u0446',	 // CYRILLIC SMALL LETTER TSE
-		'\xF7':	'\u0447',	 // CYRILLIC SMALL LETTER CHE
-		'\xF8':	'\u0448',	 // CYRILLIC SMALL LETTER SHA
-		'\xF9':	'\u0449',	 // CYRILLIC SMALL LETTER SHCHA
-		'\xFA':	'\u044A',	 // CYRILLIC SMALL LETTER HARD SIGN
-		'\xFB':	'\u044B',	 // CYRILLIC SMALL LETTER YERU
-		'\xFC':	'\u044C',	 // CYRILLIC SMALL LETTER SOFT SIGN
-		'\xFD':	'\u044D',	 // CYRILLIC SMALL LETTER E
-		'\xFE':	'\u044E',	 // CYRILLIC SMALL LETTER YU
-		'\xFF':	'\u044F',	 // CYRILLIC SMALL LETTER YA
-
-	}
-
-	charmapEncode := reverseByteRuneMap(charmapDecode)
-
-	newCodec := &codecMap8Bit{EncodeMap: charmapEncode, DecodeMap: charmapDecode}
-
-	register(newCodec, "CP1251", "CP-1251", "1251", "WINDOWS-1251")
+var tableCP1251 = [256]rune{
+	'\x00':	'\u0000',	 // NULL
+	'\x01':	'\u0001',	 // START OF HEADING
+	'\x02':	'\u0002',	 // START OF TEXT
+	'\x03':	'\u0003',	 // END OF TEXT
+	'\x04':	'\u0004',	 // END OF TRANSMISSION
+	'\x05':	'\u0005',	 // ENQUIRY
+	'\x06':	'\u0006',	 // ACKNOWLEDGE
+	'\x07':	'\u0007',	 // BELL
+	'\x08':	'\u0008',	 // BACKSPACE
+	'\x09':	'\u0009',	 // HORIZONTAL TABULATION
+	'\x0A':	'\u000A',	 // LINE FEED
+	'\x0B':	'\u000B',	 // VERTICAL TABULATION
+	'\x0C':	'\u000C',	 // FORM FEED
+	'\x0D':	'\u000D',	 // CARRIAGE RETURN
+	'\x0E':	'\u000E',	 // SHIFT OUT
+	'\x0F':	'\u000F',	 // SHIFT IN
+	'\x10':	'\u0010',	 // DATA LINK ESCAPE
+	'\x11':	'\u0011',	 // DEVICE CONTROL ONE
+	'\x12':	'\u0012',	 // DEVICE CONTROL TWO
+	'\x13':	'\u0013',	 // DEVICE CONTROL THREE
+	'\x14':	'\u0014',	 // DEVICE CONTROL FOUR
+	'\x15':	'\u0015',	 // NEGATIVE ACKNOWLEDGE
+	'\x16':	'\u0016',	 // SYNCHRONOUS IDLE
+	'\x17':	'\u0017',	 // END OF TRANSMISSION BLOCK
+	'\x18':	'\u0018',	 // CANCEL
+	'\x19':	'\u0019',	 // END OF MEDIUM
+	'\x1A':	'\u001A',	 // SUBSTITUTE
+	'\x1B':	'\u001B',	 // ESCAPE
+	'\x1C':	'\u001C',	 // FILE SEPARATOR
+	'\x1D':	'\u001D',	 // GROUP SEPARATOR
+	'\x1E':	'\u001E',	 // RECORD SEPARATOR
+	'\x1F':	'\u001F',	 // UNIT SEPARATOR
+	'\x20':	'\u0020',	 // SPACE
+	'\x21':	'\u0021',	 // EXCLAMATION MARK
+	'\x22':	'\u0022',	 // QUOTATION MARK
+	'\x23':	'\u0023',	 // NUMBER SIGN
+	'\x24':	'\u0024',	 // DOLLAR SIGN
+	'\x25':	'\u0025',	 // PERCENT SIGN
+	'\x26':	'\u0026',	 // AMPERSAND
+	'\x27':	'\u0027',	 // APOSTROPHE
+	'\x28':	'\u0028',	 // LEFT PARENTHESIS
+	'\x29':	'\u0029',	 // RIGHT PARENTHESIS
+	'\x2A':	'\u002A',	 // ASTERISK
+	'\x2B':	'\u002B',	 // PLUS SIGN
+	'\x2C':	'\u002C',	 // COMMA
+	'\x2D':	'\u002D',	 // HYPHEN-MINUS
+	'\x2E':	'\u002E',	 // FULL STOP
+	'\x2F':	'\u002F',	 // SOLIDUS
+	'\x30':	'\u0030',	 // DIGIT ZERO
+	'\x31':	'\u0031',	 // DIGIT ONE
+	'\x32':	'\u0032',	 // DIGIT TWO
+	'\x33':	'\u0033',	 // DIGIT THREE
+	'\x34':	'\u0034',	 // DIGIT FOUR
+	'\x35':	'\u0035',	 // DIGIT FIVE
+	'\x36':	'\u0036',	 // DIGIT SIX
+	'\x37':	'\u0037',	 // DIGIT SEVEN
+	'\x38':	'\u0038',	 // DIGIT EIGHT
+	'\x39':	'\u0039',	 // DIGIT NINE
+	'\x3A':	'\u003A',	 // COLON
+	'\x3B':	'\u003B',	 // SEMICOLON
+	'\x3C':	'\u003C',	 // LESS-THAN SIGN
+	'\x3D':	'\u003D',	 // EQUALS SIGN
+	'\x3E':	'\u003E',	 // GREATER-THAN SIGN
+	'\x3F':	'\u003F',	 // QUESTION MARK
+	'\x40':	'\u0040',	 // COMMERCIAL AT
+	'\x41':	'\u0041',	 // LATIN CAPITAL LETTER A
+	'\x42':	'\u0042',	 // LATIN CAPITAL LETTER B
+	'\x43':	'\u0043',	 // LATIN CAPITAL LETTER C
+	'\x44':	'\u0044',	 // LATIN CAPITAL LETTER D
+	'\x45':	'\u0045',	 // LATIN CAPITAL LETTER E
+	'\x46':	'\u0046',	 // LATIN CAPITAL LETTER F
+	'\x47':	'\u0047',	 // LATIN CAPITAL LETTER G
+	'\x48':	'\u0048',	 // LATIN CAPITAL LETTER H
+	'\x49':	'\u0049',	 // LATIN CAPITAL LETTER I
+	'\x4A':	'\u004A',	 // LATIN CAPITAL LETTER J
+	'\x4B':	'\u004B',	 // LATIN CAPITAL LETTER K
+	'\x4C':	'\u004C',	 // LATIN CAPITAL LETTER L
+	'\x4D':	'\u004D',	 // LATIN CAPITAL LETTER M
+	'\x4E':	'\u004E',	 // LATIN CAPITAL LETTER N
+	'\x4F':	'\u004F',	 // LATIN CAPITAL LETTER O
+	'\x50':	'\u0050',	 // LATIN CAPITAL LETTER P
+	'\x51':	'\u0051',	 // LATIN CAPITAL LETTER Q
+	'\x52':	'\u0052',	 // LATIN CAPITAL LETTER R
+	'\x53':	'\u0053',	 // LATIN CAPITAL LETTER S
+	'\x54':	'\u0054',	 // LATIN CAPITAL LETTER T
+	'\x55':	'\u0055',	 // LATIN CAPITAL LETTER U
+	'\x56':	'\u0056',	 // LATIN CAPITAL LETTER V
+	'\x57':	'\u0057',	 // LATIN CAPITAL LETTER W
+	'\x58':	'\u0058',	 // LATIN CAPITAL LETTER X
+	'\x59':	'\u0059',	 // LATIN CAPITAL LETTER Y
+	'\x5A':	'\u005A',	 // LATIN CAPITAL LETTER Z
+	'\x5B':	'\u005B',	 // LEFT SQUARE BRACKET
+	'\x5C':	'\u005C',	 // REVERSE SOLIDUS
+	'\x5D':	'\u005D',	 // RIGHT SQUARE BRACKET
+	'\x5E':	'\u005E',	 // CIRCUMFLEX ACCENT
+	'\x5F':	'\u005F',	 // LOW LINE
+	'\x60':	'\u0060',	 // GRAVE ACCENT
+	'\x61':	'\u0061',	 // LATIN SMALL LETTER A
+	'\x62':	'\u0062',	 // LATIN SMALL LETTER B
+	'\x63':	'\u0063',	 // LATIN SMALL LETTER C
+	'\x64':	'\u0064',	 // LATIN SMALL LETTER D
+	'\x65':	'\u0065',	 // LATIN SMALL LETTER E
+	'\x66':	'\u0066',	 // LATIN SMALL LETTER F
+	'\x67':	'\u0067',	 // LATIN SMALL LETTER G
+	'\x68':	'\u0068',	 // LATIN SMALL LETTER H
+	'\x69':	'\u0069',	 // LATIN SMALL LETTER I
+	'\x6A':	'\u006A',	 // LATIN SMALL LETTER J
+	'\x6B':	'\u006B',	 // LATIN SMALL LETTER K
+	'\x6C':	'\u006C',	 // LATIN SMALL LETTER L
+	'\x6D':	'\u006D',	 // LATIN SMALL LETTER M
+	'\x6E':	'\u006E',	 // LATIN SMALL LETTER N
+	'\x6F':	'\u006F',	 // LATIN SMALL LETTER O
+	'\x70':	'\u0070',	 // LATIN SMALL LETTER P
+	'\x71':	'\u0071',	 // LATIN SMALL LETTER Q
+	'\x72':	'\u0072',	 // LATIN SMALL LETTER R
+	'\x73':	'\u0073',	 // LATIN SMALL LETTER S
+	'\x74':	'\u0074',	 // LATIN SMALL LETTER T
+	'\x75':	'\u0075',	 // LATIN SMALL LETTER U
+	'\x76':	'\u0076',	 // LATIN SMALL LETTER V
+	'\x77':	'\u0077',	 // LATIN SMALL LETTER W
+	'\x78':	'\u0078',	 // LATIN SMALL LETTER X
+	'\x79':	'\u0079',	 // LATIN SMALL LETTER Y
+	'\x7A':	'\u007A',	 // LATIN SMALL LETTER Z
+	'\x7B':	'\u007B',	 // LEFT CURLY BRACKET
+	'\x7C':	'\u007C',	 // VERTICAL LINE
+	'\x7D':	'\u007D',	 // RIGHT CURLY BRACKET
+	'\x7E':	'\u007E',	 // TILDE
+	'\x7F':	'\u007F',	 // DELETE
+	'\x80':	'\u0402',	 // CYRILLIC CAPITAL LETTER DJE
+	'\x81':	'\u0403',	 // CYRILLIC CAPITAL LETTER GJE
+	'\x82':	'\u201A',	 // SINGLE LOW-9 QUOTATION MARK
+	'\x83':	'\u0453',	 // CYRILLIC SMALL LETTER GJE
+	'\x84':	'\u201E',	 // DOUBLE LOW-9 QUOTATION MARK
+	'\x85':	'\u2026',	 // HORIZONTAL ELLIPSIS
+	'\x86':	'\u2020',	 // DAGGER
+	'\x87':	'\u2021',	 // DOUBLE DAGGER
+	'\x88':	'\u20AC',	 // EURO SIGN
+	'\x89':	'\u2030',	 // PER MILLE SIGN
+	'\x8A':	'\u0409',	 // CYRILLIC CAPITAL LETTER LJE
+	'\x8B':	'\u2039',	 // SINGLE LEFT-POINTING ANGLE QUOTATION MARK
+	'\x8C':	'\u040A',	 // CYRILLIC CAPITAL LETTER NJE
+	'\x8D':	'\u040C',	 // CYRILLIC CAPITAL LETTER KJE
+	'\x8E':	'\u040B',	 // CYRILLIC CAPITAL LETTER TSHE
+	'\x8F':	'\u040F',	 // CYRILLIC CAPITAL LETTER DZHE
+	'\x90':	'\u0452',	 // CYRILLIC SMALL LETTER DJE
+	'\x91':	'\u2018',	 // LEFT SINGLE QUOTATION MARK
+	'\x92':	'\u2019',	 // RIGHT SINGLE QUOTATION MARK
+	'\x93':	'\u201C',	 // LEFT DOUBLE QUOTATION MARK
+	'\x94':	'\u201D',	 // RIGHT DOUBLE QUOTATION MARK
+	'\x95':	'\u2022',	 // BULLET
+	'\x96':	'\u2013',	 // EN DASH
+	'\x97':	'\u2014',	 // EM DASH
+	'\x98':	undefinedRune,	 // UNDEFINED
+	'\x99':	'\u2122',	 // TRADE MARK SIGN
+	'\x9A':	'\u0459',	 // CYRILLIC SMALL LETTER LJE
+	'\x9B':	'\u203A',	 // SINGLE RIGHT-POINTING ANGLE QUOTATION MARK
+	'\x9C':	'\u045A',	 // CYRILLIC SMALL LETTER NJE
+	'\x9D':	'\u045C',	 // CYRILLIC SMALL LETTER KJE
+	'\x9E':	'\u045B',	 // CYRILLIC SMALL LETTER TSHE
+	'\x9F':	'\u045F',	 // CYRILLIC SMALL LETTER DZHE
+	'\xA0':	'\u00A0',	 // NO-BREAK SPACE
+	'\xA1':	'\u040E',	 // CYRILLIC CAPITAL LETTER SHORT U
+	'\xA2':	'\u045E',	 // CYRILLIC SMALL LETTER SHORT U
+	'\xA3':	'\u0408',	 // CYRILLIC CAPITAL LETTER JE
+	'\xA4':	'\u00A4',	 // CURRENCY SIGN
+	'\xA5':	'\u0490',	 // CYRILLIC CAPITAL LETTER GHE WITH UPTURN
+	'\xA6':	'\u00A6',	 // BROKEN BAR
+	'\xA7':	'\u00A7',	 // SECTION SIGN
+	'\xA8':	'\u0401',	 // CYRILLIC CAPITAL LETTER IO
+	'\xA9':	'\u00A9',	 // COPYRIGHT SIGN
+	'\xAA':	'\u0404',	 // CYRILLIC CAPITAL LETTER UKRAINIAN IE
+	'\xAB':	'\u00AB',	 // LEFT-POINTING DOUBLE ANGLE QUOTATION MARK
+	'\xAC':	'\u00AC',	 // NOT SIGN
+	'\xAD':	'\u00AD',	 // SOFT HYPHEN
+	'\xAE':	'\u00AE',	 // REGISTERED SIGN
+	'\xAF':	'\u0407',	 // CYRILLIC CAPITAL LETTER YI
+	'\xB0':	'\u00B0',	 // DEGREE SIGN
+	'\xB1':	'\u00B1',	 // PLUS-MINUS SIGN
+	'\xB2':	'\u0406',	 // CYRILLIC CAPITAL LETTER BYELORUSSIAN-UKRAINIAN I
+	'\xB3':	'\u0456',	 // CYRILLIC SMALL LETTER BYELORUSSIAN-UKRAINIAN I
+	'\xB4':	'\u0491',	 // CYRILLIC SMALL LETTER GHE WITH UPTURN
+	'\xB5':	'\u00B5',	 // MICRO SIGN
+	'\xB6':	'\u00B6',	 // PILCROW SIGN
+	'\xB7':	'\u00B7',	 // MIDDLE DOT
+	'\xB8':	'\u0451',	 // CYRILLIC SMALL LETTER IO
+	'\xB9':	'\u2116',	 // NUMERO SIGN
+	'\xBA':	'\u0454',	 // CYRILLIC SMALL LETTER UKRAINIAN IE
+	'\xBB':	'\u00BB',	 // RIGHT-POINTING DOUBLE ANGLE QUOTATION MARK
+	'\xBC':	'\u0458',	 // CYRILLIC SMALL LETTER JE
+	'\xBD':	'\u0405',	 // CYRILLIC CAPITAL LETTER DZE
+	'\xBE':	'\u0455',	 // CYRILLIC SMALL LETTER DZE
+	'\xBF':	'\u0457',	 // CYRILLIC SMALL LETTER YI
+	'\xC0':	'\u0410',	 // CYRILLIC CAPITAL LETTER A
+	'\xC1':	'\u0411',	 // CYRILLIC CAPITAL LETTER BE
+	'\xC2':	'\u0412',	 // CYRILLIC CAPITAL LETTER VE
+	'\xC3':	'\u0413',	 // CYRILLIC CAPITAL LETTER GHE
+	'\xC4':	'\u0414',	 // CYRILLIC CAPITAL LETTER DE
+	'\xC5':	'\u0415',	 // CYRILLIC CAPITAL LETTER IE
+	'\xC6':	'\u0416',	 // CYRILLIC CAPITAL LETTER ZHE
+	'\xC7':	'\u0417',	 // CYRILLIC CAPITAL LETTER ZE
+	'\xC8':	'\u0418',	 // CYRILLIC CAPITAL LETTER I
+	'\xC9':	'\u0419',	 // CYRILLIC CAPITAL LETTER SHORT I
+	'\xCA':	'\u041A',	 // CYRILLIC CAPITAL LETTER KA
+	'\xCB':	'\u041B',	 // CYRILLIC CAPITAL LETTER EL
+	'\xCC':	'\u041C',	 // CYRILLIC CAPITAL LETTER EM
+	'\xCD':	'\u041D',	 // CYRILLIC CAPITAL LETTER EN
+	'\xCE':	'\u041E',	 // CYRILLIC CAPITAL LETTER O
+	'\xCF':	'\u041F',	 // CYRILLIC CAPITAL LETTER PE
+	'\xD0':	'\u0420',	 // CYRILLIC CAPITAL LETTER ER
+	'\xD1':	'\u0421',	 // CYRILLIC CAPITAL LETTER ES
+	'\xD2':	'\u0422',	 // CYRILLIC CAPITAL LETTER TE
+	'\xD3':	'\u0423',	 // CYRILLIC CAPITAL LETTER U
+	'\xD4':	'\u0424',	 // CYRILLIC CAPITAL LETTER EF
+	'\xD5':	'\u0425',	 // CYRILLIC CAPITAL LETTER HA
+	'\xD6':	'\u0426',	 // CYRILLIC CAPITAL LETTER TSE
+	'\xD7':	'\u0427',	 // CYRILLIC CAPITAL LETTER CHE
+	'\xD8':	'\u0428',	 // CYRILLIC CAPITAL LETTER SHA
+	'\xD9':	'\u0429',	 // CYRILLIC CAPITAL LETTER SHCHA
+	'\xDA':	'\u042A',	 // CYRILLIC CAPITAL LETTER HARD SIGN
+	'\xDB':	'\u042B',	 // CYRILLIC CAPITAL LETTER YERU
+	'\xDC':	'\u042C',	 // CYRILLIC CAPITAL LETTER SOFT SIGN
+	'\xDD':	'\u042D',	 // CYRILLIC CAPITAL LETTER E
+	'\xDE':	'\u042E',	 // CYRILLIC CAPITAL LETTER YU
+	'\xDF':	'\u042F',	 // CYRILLIC CAPITAL LETTER YA
+	'\xE0':	'\u0430',	 // CYRILLIC SMALL LETTER A
+	'\xE1':	'\u0431',	 // CYRILLIC SMALL LETTER BE
+	'\xE2':	'\u0432',	 // CYRILLIC SMALL LETTER VE
+	'\xE3':	'\u0433',	 // CYRILLIC SMALL LETTER GHE
+	'\xE4':	'\u0434',	 // CYRILLIC SMALL LETTER DE
+	'\xE5':	'\u0435',	 // CYRILLIC SMALL LETTER IE
+	'\xE6':	'\u0436',	 // CYRILLIC SMALL LETTER ZHE
+	'\xE7':	'\u0437',	 // CYRILLIC SMALL LETTER ZE
+	'\xE8':	'\u0438',	 // CYRILLIC SMALL LETTER I
+	'\xE9':	'\u0439',	 // CYRILLIC SMALL LETTER SHORT I
+	'\xEA':	'\u043A',	 // CYRILLIC SMALL LETTER KA
+	'\xEB':	'\u043B',	 // CYRILLIC SMALL LETTER EL
+	'\xEC':	'\u043C',	 // CYRILLIC SMALL LETTER EM
+	'\xED':	'\u043D',	 // CYRILLIC SMALL LETTER EN
+	'\xEE':	'\u043E',	 // CYRILLIC SMALL LETTER O
+	'\xEF':	'\u043F',	 // CYRILLIC SMALL LETTER PE
+	'\xF0':	'\u0440',	 // CYRILLIC SMALL LETTER ER
+	'\xF1':	'\u0441',	 // CYRILLIC SMALL LETTER ES
+	'\xF2':	'\u0442',	 // CYRILLIC SMALL LETTER TE
+	'\xF3':	'\u0443',	 // CYRILLIC SMALL LETTER U
+	'\xF4':	'\u0444',	 // CYRILLIC SMALL LETTER EF
+	'\xF5':	'\u0445',	 // CYRILLIC SMALL LETTER HA
+	'\xF6':	'\u0446',	 // CYRILLIC SMALL LETTER TSE
+	'\xF7':	'\u0447',	 // CYRILLIC SMALL LETTER CHE
+	'\xF8':	'\u0448',	 // CYRILLIC SMALL LETTER SHA
+	'\xF9':	'\u0449',	 // CYRILLIC SMALL LETTER SHCHA
+	'\xFA':	'\u044A',	 // CYRILLIC SMALL LETTER HARD SIGN
+	'\xFB':	'\u044B',	 // CYRILLIC SMALL LETTER YERU
+	'\xFC':	'\u044C',	 // CYRILLIC SMALL LETTER SOFT SIGN
+	'\xFD':	'\u044D',	 // CYRILLIC SMALL LETTER E
+	'\xFE':	'\u044E',	 // CYRILLIC SMALL LETTER YU
+	'\xFF':	'\u044F',	 // CYRILLIC SMALL LETTER YA
+}
 
+func init() {
+	setBuiltinTable("CP1251", &tableCP1251)
 }
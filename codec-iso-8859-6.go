@@ -1,227 +1,266 @@
+//go:build !charmap_no_iso8859 && !charmap_only_cyrillic
 
 package charmap
 
-func init() {
-
-	charmapDecode := map[byte]rune{
-		'\x00':	'\u0000',	 // 	NULL
-		'\x01':	'\u0001',	 // 	START OF HEADING
-		'\x02':	'\u0002',	 // 	START OF TEXT
-		'\x03':	'\u0003',	 // 	END OF TEXT
-		'\x04':	'\u0004',	 // 	END OF TRANSMISSION
-		'\x05':	'\u0005',	 // 	ENQUIRY
-		'\x06':	'\u0006',	 // 	ACKNOWLEDGE
-		'\x07':	'\u0007',	 // 	BELL
-		'\x08':	'\u0008',	 // 	BACKSPACE
-		'\x09':	'\u0009',	 // 	HORIZONTAL TABULATION
-		'\x0A':	'\u000A',	 // 	LINE FEED
-		'\x0B':	'\u000B',	 // 	VERTICAL TABULATION
-		'\x0C':	'\u000C',	 // 	FORM FEED
-		'\x0D':	'\u000D',	 // 	CARRIAGE RETURN
-		'\x0E':	'\u000E',	 // 	SHIFT OUT
-		'\x0F':	'\u000F',	 // 	SHIFT IN
-		'\x10':	'\u0010',	 // 	DATA LINK ESCAPE
-		'\x11':	'\u0011',	 // 	DEVICE CONTROL ONE
-		'\x12':	'\u0012',	 // 	DEVICE CONTROL TWO
-		'\x13':	'\u0013',	 // 	DEVICE CONTROL THREE
-		'\x14':	'\u0014',	 // 	DEVICE CONTROL FOUR
-		'\x15':	'\u0015',	 // 	NEGATIVE ACKNOWLEDGE
-		'\x16':	'\u0016',	 // 	SYNCHRONOUS IDLE
-		'\x17':	'\u0017',	 // 	END OF TRANSMISSION BLOCK
-		'\x18':	'\u0018',	 // 	CANCEL
-		'\x19':	'\u0019',	 // 	END OF MEDIUM
-		'\x1A':	'\u001A',	 // 	SUBSTITUTE
-		'\x1B':	'\u001B',	 // 	ESCAPE
-		'\x1C':	'\u001C',	 // 	FILE SEPARATOR
-		'\x1D':	'\u001D',	 // 	GROUP SEPARATOR
-		'\x1E':	'\u001E',	 // 	RECORD SEPARATOR
-		'\x1F':	'\u001F',	 // 	UNIT SEPARATOR
-		'\x20':	'\u0020',	 // 	SPACE
-		'\x21':	'\u0021',	 // 	EXCLAMATION MARK
-		'\x22':	'\u0022',	 // 	QUOTATION MARK
-		'\x23':	'\u0023',	 // 	NUMBER SIGN
-		'\x24':	'\u0024',	 // 	DOLLAR SIGN
-		'\x25':	'\u0025',	 // 	PERCENT SIGN
-		'\x26':	'\u0026',	 // 	AMPERSAND
-		'\x27':	'\u0027',	 // 	APOSTROPHE
-		'\x28':	'\u0028',	 // 	LEFT PARENTHESIS
-		'\x29':	'\u0029',	 // 	RIGHT PARENTHESIS
-		'\x2A':	'\u002A',	 // 	ASTERISK
-		'\x2B':	'\u002B',	 // 	PLUS SIGN
-		'\x2C':	'\u002C',	 // 	COMMA
-		'\x2D':	'\u002D',	 // 	HYPHEN-MINUS
-		'\x2E':	'\u002E',	 // 	FULL STOP
-		'\x2F':	'\u002F',	 // 	SOLIDUS
-		'\x30':	'\u0030',	 // 	DIGIT ZERO
-		'\x31':	'\u0031',	 // 	DIGIT ONE
-		'\x32':	'\u0032',	 // 	DIGIT TWO
-		'\x33':	'\u0033',	 // 	DIGIT THREE
-		'\x34':	'\u0034',	 // 	DIGIT FOUR
-		'\x35':	'\u0035',	 // 	DIGIT FIVE
-		'\x36':	'\u0036',	 // 	DIGIT SIX
-		'\x37':	'\u0037',	 // 	DIGIT SEVEN
-		'\x38':	'\u0038',	 // 	DIGIT EIGHT
-		'\x39':	'\u0039',	 // 	DIGIT NINE
-		'\x3A':	'\u003A',	 // 	COLON
-		'\x3B':	'\u003B',	 // 	SEMICOLON
-		'\x3C':	'\u003C',	 // 	LESS-THAN SIGN
-		'\x3D':	'\u003D',	 // 	EQUALS SIGN
-		'\x3E':	'\u003E',	 // 	GREATER-THAN SIGN
-		'\x3F':	'\u003F',	 // 	QUESTION MARK
-		'\x40':	'\u0040',	 // 	COMMERCIAL AT
-		'\x41':	'\u0041',	 // 	LATIN CAPITAL LETTER A
-		'\x42':	'\u0042',	 // 	LATIN CAPITAL LETTER B
-		'\x43':	'\u0043',	 // 	LATIN CAPITAL LETTER C
-		'\x44':	'\u0044',	 // 	LATIN CAPITAL LETTER D
-		'\x45':	'\u0045',	 // 	LATIN CAPITAL LETTER E
-		'\x46':	'\u0046',	 // 	LATIN CAPITAL LETTER F
-		'\x47':	'\u0047',	 // 	LATIN CAPITAL LETTER G
-		'\x48':	'\u0048',	 // 	LATIN CAPITAL LETTER H
-		'\x49':	'\u0049',	 // 	LATIN CAPITAL LETTER I
-		'\x4A':	'\u004A',	 // 	LATIN CAPITAL LETTER J
-		'\x4B':	'\u004B',	 // 	LATIN CAPITAL LETTER K
-		'\x4C':	'\u004C',	 // 	LATIN CAPITAL LETTER L
-		'\x4D':	'\u004D',	 // 	LATIN CAPITAL LETTER M
-		'\x4E':	'\u004E',	 // 	LATIN CAPITAL LETTER N
-		'\x4F':	'\u004F',	 // 	LATIN CAPITAL LETTER O
-		'\x50':	'\u0050',	 // 	LATIN CAPITAL LETTER P
-		'\x51':	'\u0051',	 // 	LATIN CAPITAL LETTER Q
-		'\x52':	'\u0052',	 // 	LATIN CAPITAL LETTER R
-		'\x53':	'\u0053',	 // 	LATIN CAPITAL LETTER S
-		'\x54':	'\u0054',	 // 	LATIN CAPITAL LETTER T
-		'\x55':	'\u0055',	 // 	LATIN CAPITAL LETTER U
-		'\x56':	'\u0056',	 // 	LATIN CAPITAL LETTER V
-		'\x57':	'\u0057',	 // 	LATIN CAPITAL LETTER W
-		'\x58':	'\u0058',	 // 	LATIN CAPITAL LETTER X
-		'\x59':	'\u0059',	 // 	LATIN CAPITAL LETTER Y
-		'\x5A':	'\u005A',	 // 	LATIN CAPITAL LETTER Z
-		'\x5B':	'\u005B',	 // 	LEFT SQUARE BRACKET
-		'\x5C':	'\u005C',	 // 	REVERSE SOLIDUS
-		'\x5D':	'\u005D',	 // 	RIGHT SQUARE BRACKET
-		'\x5E':	'\u005E',	 // 	CIRCUMFLEX ACCENT
-		'\x5F':	'\u005F',	 // 	LOW LINE
-		'\x60':	'\u0060',	 // 	GRAVE ACCENT
-		'\x61':	'\u0061',	 // 	LATIN SMALL LETTER A
-		'\x62':	'\u0062',	 // 	LATIN SMALL LETTER B
-		'\x63':	'\u0063',	 // 	LATIN SMALL LETTER C
-		'\x64':	'\u0064',	 // 	LATIN SMALL LETTER D
-		'\x65':	'\u0065',	 // 	LATIN SMALL LETTER E
-		'\x66':	'\u0066',	 // 	LATIN SMALL LETTER F
-		'\x67':	'\u0067',	 // 	LATIN SMALL LETTER G
-		'\x68':	'\u0068',	 // 	LATIN SMALL LETTER H
-		'\x69':	'\u0069',	 // 	LATIN SMALL LETTER I
-		'\x6A':	'\u006A',	 // 	LATIN SMALL LETTER J
-		'\x6B':	'\u006B',	 // 	LATIN SMALL LETTER K
-		'\x6C':	'\u006C',	 // 	LATIN SMALL LETTER L
-		'\x6D':	'\u006D',	 // 	LATIN SMALL LETTER M
-		'\x6E':	'\u006E',	 // 	LATIN SMALL LETTER N
-		'\x6F':	'\u006F',	 // 	LATIN SMALL LETTER O
-		'\x70':	'\u0070',	 // 	LATIN SMALL LETTER P
-		'\x71':	'\u0071',	 // 	LATIN SMALL LETTER Q
-		'\x72':	'\u0072',	 // 	LATIN SMALL LETTER R
-		'\x73':	'\u0073',	 // 	LATIN SMALL LETTER S
-		'\x74':	'\u0074',	 // 	LATIN SMALL LETTER T
-		'\x75':	'\u0075',	 // 	LATIN SMALL LETTER U
-		'\x76':	'\u0076',	 // 	LATIN SMALL LETTER V
-		'\x77':	'\u0077',	 // 	LATIN SMALL LETTER W
-		'\x78':	'\u0078',	 // 	LATIN SMALL LETTER X
-		'\x79':	'\u0079',	 // 	LATIN SMALL LETTER Y
-		'\x7A':	'\u007A',	 // 	LATIN SMALL LETTER Z
-		'\x7B':	'\u007B',	 // 	LEFT CURLY BRACKET
-		'\x7C':	'\u007C',	 // 	VERTICAL LINE
-		'\x7D':	'\u007D',	 // 	RIGHT CURLY BRACKET
-		'\x7E':	'\u007E',	 // 	TILDE
-		'\x7F':	'\u007F',	 // 	DELETE
-		'\x80':	'\u0080',	 // 	<control>
-		'\x81':	'\u0081',	 // 	<control>
-		'\x82':	'\u0082',	 // 	<control>
-		'\x83':	'\u0083',	 // 	<control>
-		'\x84':	'\u0084',	 // 	<control>
-		'\x85':	'\u0085',	 // 	<control>
-		'\x86':	'\u0086',	 // 	<control>
-		'\x87':	'\u0087',	 // 	<control>
-		'\x88':	'\u0088',	 // 	<control>
-		'\x89':	'\u0089',	 // 	<control>
-		'\x8A':	'\u008A',	 // 	<control>
-		'\x8B':	'\u008B',	 // 	<control>
-		'\x8C':	'\u008C',	 // 	<control>
-		'\x8D':	'\u008D',	 // 	<control>
-		'\x8E':	'\u008E',	 // 	<control>
-		'\x8F':	'\u008F',	 // 	<control>
-		'\x90':	'\u0090',	 // 	<control>
-		'\x91':	'\u0091',	 // 	<control>
-		'\x92':	'\u0092',	 // 	<control>
-		'\x93':	'\u0093',	 // 	<control>
-		'\x94':	'\u0094',	 // 	<control>
-		'\x95':	'\u0095',	 // 	<control>
-		'\x96':	'\u0096',	 // 	<control>
-		'\x97':	'\u0097',	 // 	<control>
-		'\x98':	'\u0098',	 // 	<control>
-		'\x99':	'\u0099',	 // 	<control>
-		'\x9A':	'\u009A',	 // 	<control>
-		'\x9B':	'\u009B',	 // 	<control>
-		'\x9C':	'\u009C',	 // 	<control>
-		'\x9D':	'\u009D',	 // 	<control>
-		'\x9E':	'\u009E',	 // 	<control>
-		'\x9F':	'\u009F',	 // 	<control>
-		'\xA0':	'\u00A0',	 // 	NO-BREAK SPACE
-		'\xA4':	'\u00A4',	 // 	CURRENCY SIGN
-		'\xAC':	'\u060C',	 // 	ARABIC COMMA
-		'\xAD':	'\u00AD',	 // 	SOFT HYPHEN
-		'\xBB':	'\u061B',	 // 	ARABIC SEMICOLON
-		'\xBF':	'\u061F',	 // 	ARABIC QUESTION MARK
-		'\xC1':	'\u0621',	 // 	ARABIC LETTER HAMZA
-		'\xC2':	'\u0622',	 // 	ARABIC LETTER ALEF WITH MADDA ABOVE
-		'\xC3':	'\u0623',	 // 	ARABIC LETTER ALEF WITH HAMZA ABOVE
-		'\xC4':	'\u0624',	 // 	ARABIC LETTER WAW WITH HAMZA ABOVE
-		'\xC5':	'\u0625',	 // 	ARABIC LETTER ALEF WITH HAMZA BELOW
-		'\xC6':	'\u0626',	 // 	ARABIC LETTER YEH WITH HAMZA ABOVE
-		'\xC7':	'\u0627',	 // 	ARABIC LETTER ALEF
-		'\xC8':	'\u0628',	 // 	ARABIC LETTER BEH
-		'\xC9':	'\u0629',	 // 	ARABIC LETTER TEH MARBUTA
-		'\xCA':	'\u062A',	 // 	ARABIC LETTER TEH
-		'\xCB':	'\u062B',	 // 	ARABIC LETTER THEH
-		'\xCC':	'\u062C',	 // 	ARABIC LETTER JEEM
-		'\xCD':	'\u062D',	 // 	ARABIC LETTER HAH
-		'\xCE':	'\u062E',	 // 	ARABIC LETTER KHAH
-		'\xCF':	'\u062F',	 // 	ARABIC LETTER DAL
-		'\xD0':	'\u0630',	 // 	ARABIC LETTER THAL
-		'\xD1':	'\u0631',	 // 	ARABIC LETTER REH
-		'\xD2':	'\u0632',	 // 	ARABIC LETTER ZAIN
-		'\xD3':	'\u0633',	 // 	ARABIC LETTER SEEN
-		'\xD4':	'\u0634',	 // 	ARABIC LETTER SHEEN
-		'\xD5':	'\u0635',	 // 	ARABIC LETTER SAD
-		'\xD6':	'\u0636',	 // 	ARABIC LETTER DAD
-		'\xD7':	'\u0637',	 // 	ARABIC LETTER TAH
-		'\xD8':	'\u0638',	 // 	ARABIC LETTER ZAH
-		'\xD9':	'\u0639',	 // 	ARABIC LETTER AIN
-		'\xDA':	'\u063A',	 // 	ARABIC LETTER GHAIN
-		'\xE0':	'\u0640',	 // 	ARABIC TATWEEL
-		'\xE1':	'\u0641',	 // 	ARABIC LETTER FEH
-		'\xE2':	'\u0642',	 // 	ARABIC LETTER QAF
-		'\xE3':	'\u0643',	 // 	ARABIC LETTER KAF
-		'\xE4':	'\u0644',	 // 	ARABIC LETTER LAM
-		'\xE5':	'\u0645',	 // 	ARABIC LETTER MEEM
-		'\xE6':	'\u0646',	 // 	ARABIC LETTER NOON
-		'\xE7':	'\u0647',	 // 	ARABIC LETTER HEH
-		'\xE8':	'\u0648',	 // 	ARABIC LETTER WAW
-		'\xE9':	'\u0649',	 // 	ARABIC LETTER ALEF MAKSURA
-		'\xEA':	'\u064A',	 // 	ARABIC LETTER YEH
-		'\xEB':	'\u064B',	 // 	ARABIC FATHATAN
-		'\xEC':	'\u064C',	 // 	ARABIC DAMMATAN
-		'\xED':	'\u064D',	 // 	ARABIC KASRATAN
-		'\xEE':	'\u064E',	 // 	ARABIC FATHA
-		'\xEF':	'\u064F',	 // 	ARABIC DAMMA
-		'\xF0':	'\u0650',	 // 	ARABIC KASRA
-		'\xF1':	'\u0651',	 // 	ARABIC SHADDA
-		'\xF2':	'\u0652',	 // 	ARABIC SUKUN
-
-	}
-
-	charmapEncode := reverseByteRuneMap(charmapDecode)
-
-	newCodec := &codecMap8Bit{EncodeMap: charmapEncode, DecodeMap: charmapDecode}
-
-	register(newCodec, "ISO-8859-6", "8859-6", "ISO8859-6")
+var tableISO_8859_6 = [256]rune{
+	'\x00':	'\u0000',	 // 	NULL
+	'\x01':	'\u0001',	 // 	START OF HEADING
+	'\x02':	'\u0002',	 // 	START OF TEXT
+	'\x03':	'\u0003',	 // 	END OF TEXT
+	'\x04':	'\u0004',	 // 	END OF TRANSMISSION
+	'\x05':	'\u0005',	 // 	ENQUIRY
+	'\x06':	'\u0006',	 // 	ACKNOWLEDGE
+	'\x07':	'\u0007',	 // 	BELL
+	'\x08':	'\u0008',	 // 	BACKSPACE
+	'\x09':	'\u0009',	 // 	HORIZONTAL TABULATION
+	'\x0A':	'\u000A',	 // 	LINE FEED
+	'\x0B':	'\u000B',	 // 	VERTICAL TABULATION
+	'\x0C':	'\u000C',	 // 	FORM FEED
+	'\x0D':	'\u000D',	 // 	CARRIAGE RETURN
+	'\x0E':	'\u000E',	 // 	SHIFT OUT
+	'\x0F':	'\u000F',	 // 	SHIFT IN
+	'\x10':	'\u0010',	 // 	DATA LINK ESCAPE
+	'\x11':	'\u0011',	 // 	DEVICE CONTROL ONE
+	'\x12':	'\u0012',	 // 	DEVICE CONTROL TWO
+	'\x13':	'\u0013',	 // 	DEVICE CONTROL THREE
+	'\x14':	'\u0014',	 // 	DEVICE CONTROL FOUR
+	'\x15':	'\u0015',	 // 	NEGATIVE ACKNOWLEDGE
+	'\x16':	'\u0016',	 // 	SYNCHRONOUS IDLE
+	'\x17':	'\u0017',	 // 	END OF TRANSMISSION BLOCK
+	'\x18':	'\u0018',	 // 	CANCEL
+	'\x19':	'\u0019',	 // 	END OF MEDIUM
+	'\x1A':	'\u001A',	 // 	SUBSTITUTE
+	'\x1B':	'\u001B',	 // 	ESCAPE
+	'\x1C':	'\u001C',	 // 	FILE SEPARATOR
+	'\x1D':	'\u001D',	 // 	GROUP SEPARATOR
+	'\x1E':	'\u001E',	 // 	RECORD SEPARATOR
+	'\x1F':	'\u001F',	 // 	UNIT SEPARATOR
+	'\x20':	'\u0020',	 // 	SPACE
+	'\x21':	'\u0021',	 // 	EXCLAMATION MARK
+	'\x22':	'\u0022',	 // 	QUOTATION MARK
+	'\x23':	'\u0023',	 // 	NUMBER SIGN
+	'\x24':	'\u0024',	 // 	DOLLAR SIGN
+	'\x25':	'\u0025',	 // 	PERCENT SIGN
+	'\x26':	'\u0026',	 // 	AMPERSAND
+	'\x27':	'\u0027',	 // 	APOSTROPHE
+	'\x28':	'\u0028',	 // 	LEFT PARENTHESIS
+	'\x29':	'\u0029',	 // 	RIGHT PARENTHESIS
+	'\x2A':	'\u002A',	 // 	ASTERISK
+	'\x2B':	'\u002B',	 // 	PLUS SIGN
+	'\x2C':	'\u002C',	 // 	COMMA
+	'\x2D':	'\u002D',	 // 	HYPHEN-MINUS
+	'\x2E':	'\u002E',	 // 	FULL STOP
+	'\x2F':	'\u002F',	 // 	SOLIDUS
+	'\x30':	'\u0030',	 // 	DIGIT ZERO
+	'\x31':	'\u0031',	 // 	DIGIT ONE
+	'\x32':	'\u0032',	 // 	DIGIT TWO
+	'\x33':	'\u0033',	 // 	DIGIT THREE
+	'\x34':	'\u0034',	 // 	DIGIT FOUR
+	'\x35':	'\u0035',	 // 	DIGIT FIVE
+	'\x36':	'\u0036',	 // 	DIGIT SIX
+	'\x37':	'\u0037',	 // 	DIGIT SEVEN
+	'\x38':	'\u0038',	 // 	DIGIT EIGHT
+	'\x39':	'\u0039',	 // 	DIGIT NINE
+	'\x3A':	'\u003A',	 // 	COLON
+	'\x3B':	'\u003B',	 // 	SEMICOLON
+	'\x3C':	'\u003C',	 // 	LESS-THAN SIGN
+	'\x3D':	'\u003D',	 // 	EQUALS SIGN
+	'\x3E':	'\u003E',	 // 	GREATER-THAN SIGN
+	'\x3F':	'\u003F',	 // 	QUESTION MARK
+	'\x40':	'\u0040',	 // 	COMMERCIAL AT
+	'\x41':	'\u0041',	 // 	LATIN CAPITAL LETTER A
+	'\x42':	'\u0042',	 // 	LATIN CAPITAL LETTER B
+	'\x43':	'\u0043',	 // 	LATIN CAPITAL LETTER C
+	'\x44':	'\u0044',	 // 	LATIN CAPITAL LETTER D
+	'\x45':	'\u0045',	 // 	LATIN CAPITAL LETTER E
+	'\x46':	'\u0046',	 // 	LATIN CAPITAL LETTER F
+	'\x47':	'\u0047',	 // 	LATIN CAPITAL LETTER G
+	'\x48':	'\u0048',	 // 	LATIN CAPITAL LETTER H
+	'\x49':	'\u0049',	 // 	LATIN CAPITAL LETTER I
+	'\x4A':	'\u004A',	 // 	LATIN CAPITAL LETTER J
+	'\x4B':	'\u004B',	 // 	LATIN CAPITAL LETTER K
+	'\x4C':	'\u004C',	 // 	LATIN CAPITAL LETTER L
+	'\x4D':	'\u004D',	 // 	LATIN CAPITAL LETTER M
+	'\x4E':	'\u004E',	 // 	LATIN CAPITAL LETTER N
+	'\x4F':	'\u004F',	 // 	LATIN CAPITAL LETTER O
+	'\x50':	'\u0050',	 // 	LATIN CAPITAL LETTER P
+	'\x51':	'\u0051',	 // 	LATIN CAPITAL LETTER Q
+	'\x52':	'\u0052',	 // 	LATIN CAPITAL LETTER R
+	'\x53':	'\u0053',	 // 	LATIN CAPITAL LETTER S
+	'\x54':	'\u0054',	 // 	LATIN CAPITAL LETTER T
+	'\x55':	'\u0055',	 // 	LATIN CAPITAL LETTER U
+	'\x56':	'\u0056',	 // 	LATIN CAPITAL LETTER V
+	'\x57':	'\u0057',	 // 	LATIN CAPITAL LETTER W
+	'\x58':	'\u0058',	 // 	LATIN CAPITAL LETTER X
+	'\x59':	'\u0059',	 // 	LATIN CAPITAL LETTER Y
+	'\x5A':	'\u005A',	 // 	LATIN CAPITAL LETTER Z
+	'\x5B':	'\u005B',	 // 	LEFT SQUARE BRACKET
+	'\x5C':	'\u005C',	 // 	REVERSE SOLIDUS
+	'\x5D':	'\u005D',	 // 	RIGHT SQUARE BRACKET
+	'\x5E':	'\u005E',	 // 	CIRCUMFLEX ACCENT
+	'\x5F':	'\u005F',	 // 	LOW LINE
+	'\x60':	'\u0060',	 // 	GRAVE ACCENT
+	'\x61':	'\u0061',	 // 	LATIN SMALL LETTER A
+	'\x62':	'\u0062',	 // 	LATIN SMALL LETTER B
+	'\x63':	'\u0063',	 // 	LATIN SMALL LETTER C
+	'\x64':	'\u0064',	 // 	LATIN SMALL LETTER D
+	'\x65':	'\u0065',	 // 	LATIN SMALL LETTER E
+	'\x66':	'\u0066',	 // 	LATIN SMALL LETTER F
+	'\x67':	'\u0067',	 // 	LATIN SMALL LETTER G
+	'\x68':	'\u0068',	 // 	LATIN SMALL LETTER H
+	'\x69':	'\u0069',	 // 	LATIN SMALL LETTER I
+	'\x6A':	'\u006A',	 // 	LATIN SMALL LETTER J
+	'\x6B':	'\u006B',	 // 	LATIN SMALL LETTER K
+	'\x6C':	'\u006C',	 // 	LATIN SMALL LETTER L
+	'\x6D':	'\u006D',	 // 	LATIN SMALL LETTER M
+	'\x6E':	'\u006E',	 // 	LATIN SMALL LETTER N
+	'\x6F':	'\u006F',	 // 	LATIN SMALL LETTER O
+	'\x70':	'\u0070',	 // 	LATIN SMALL LETTER P
+	'\x71':	'\u0071',	 // 	LATIN SMALL LETTER Q
+	'\x72':	'\u0072',	 // 	LATIN SMALL LETTER R
+	'\x73':	'\u0073',	 // 	LATIN SMALL LETTER S
+	'\x74':	'\u0074',	 // 	LATIN SMALL LETTER T
+	'\x75':	'\u0075',	 // 	LATIN SMALL LETTER U
+	'\x76':	'\u0076',	 // 	LATIN SMALL LETTER V
+	'\x77':	'\u0077',	 // 	LATIN SMALL LETTER W
+	'\x78':	'\u0078',	 // 	LATIN SMALL LETTER X
+	'\x79':	'\u0079',	 // 	LATIN SMALL LETTER Y
+	'\x7A':	'\u007A',	 // 	LATIN SMALL LETTER Z
+	'\x7B':	'\u007B',	 // 	LEFT CURLY BRACKET
+	'\x7C':	'\u007C',	 // 	VERTICAL LINE
+	'\x7D':	'\u007D',	 // 	RIGHT CURLY BRACKET
+	'\x7E':	'\u007E',	 // 	TILDE
+	'\x7F':	'\u007F',	 // 	DELETE
+	'\x80':	'\u0080',	 // 	<control>
+	'\x81':	'\u0081',	 // 	<control>
+	'\x82':	'\u0082',	 // 	<control>
+	'\x83':	'\u0083',	 // 	<control>
+	'\x84':	'\u0084',	 // 	<control>
+	'\x85':	'\u0085',	 // 	<control>
+	'\x86':	'\u0086',	 // 	<control>
+	'\x87':	'\u0087',	 // 	<control>
+	'\x88':	'\u0088',	 // 	<control>
+	'\x89':	'\u0089',	 // 	<control>
+	'\x8A':	'\u008A',	 // 	<control>
+	'\x8B':	'\u008B',	 // 	<control>
+	'\x8C':	'\u008C',	 // 	<control>
+	'\x8D':	'\u008D',	 // 	<control>
+	'\x8E':	'\u008E',	 // 	<control>
+	'\x8F':	'\u008F',	 // 	<control>
+	'\x90':	'\u0090',	 // 	<control>
+	'\x91':	'\u0091',	 // 	<control>
+	'\x92':	'\u0092',	 // 	<control>
+	'\x93':	'\u0093',	 // 	<control>
+	'\x94':	'\u0094',	 // 	<control>
+	'\x95':	'\u0095',	 // 	<control>
+	'\x96':	'\u0096',	 // 	<control>
+	'\x97':	'\u0097',	 // 	<control>
+	'\x98':	'\u0098',	 // 	<control>
+	'\x99':	'\u0099',	 // 	<control>
+	'\x9A':	'\u009A',	 // 	<control>
+	'\x9B':	'\u009B',	 // 	<control>
+	'\x9C':	'\u009C',	 // 	<control>
+	'\x9D':	'\u009D',	 // 	<control>
+	'\x9E':	'\u009E',	 // 	<control>
+	'\x9F':	'\u009F',	 // 	<control>
+	'\xA0':	'\u00A0',	 // 	NO-BREAK SPACE
+	'\xA1':	undefinedRune,	 // UNDEFINED
+	'\xA2':	undefinedRune,	 // UNDEFINED
+	'\xA3':	undefinedRune,	 // UNDEFINED
+	'\xA4':	'\u00A4',	 // 	CURRENCY SIGN
+	'\xA5':	undefinedRune,	 // UNDEFINED
+	'\xA6':	undefinedRune,	 // UNDEFINED
+	'\xA7':	undefinedRune,	 // UNDEFINED
+	'\xA8':	undefinedRune,	 // UNDEFINED
+	'\xA9':	undefinedRune,	 // UNDEFINED
+	'\xAA':	undefinedRune,	 // UNDEFINED
+	'\xAB':	undefinedRune,	 // UNDEFINED
+	'\xAC':	'\u060C',	 // 	ARABIC COMMA
+	'\xAD':	'\u00AD',	 // 	SOFT HYPHEN
+	'\xAE':	undefinedRune,	 // UNDEFINED
+	'\xAF':	undefinedRune,	 // UNDEFINED
+	'\xB0':	undefinedRune,	 // UNDEFINED
+	'\xB1':	undefinedRune,	 // UNDEFINED
+	'\xB2':	undefinedRune,	 // UNDEFINED
+	'\xB3':	undefinedRune,	 // UNDEFINED
+	'\xB4':	undefinedRune,	 // UNDEFINED
+	'\xB5':	undefinedRune,	 // UNDEFINED
+	'\xB6':	undefinedRune,	 // UNDEFINED
+	'\xB7':	undefinedRune,	 // UNDEFINED
+	'\xB8':	undefinedRune,	 // UNDEFINED
+	'\xB9':	undefinedRune,	 // UNDEFINED
+	'\xBA':	undefinedRune,	 // UNDEFINED
+	'\xBB':	'\u061B',	 // 	ARABIC SEMICOLON
+	'\xBC':	undefinedRune,	 // UNDEFINED
+	'\xBD':	undefinedRune,	 // UNDEFINED
+	'\xBE':	undefinedRune,	 // UNDEFINED
+	'\xBF':	'\u061F',	 // 	ARABIC QUESTION MARK
+	'\xC0':	undefinedRune,	 // UNDEFINED
+	'\xC1':	'\u0621',	 // 	ARABIC LETTER HAMZA
+	'\xC2':	'\u0622',	 // 	ARABIC LETTER ALEF WITH MADDA ABOVE
+	'\xC3':	'\u0623',	 // 	ARABIC LETTER ALEF WITH HAMZA ABOVE
+	'\xC4':	'\u0624',	 // 	ARABIC LETTER WAW WITH HAMZA ABOVE
+	'\xC5':	'\u0625',	 // 	ARABIC LETTER ALEF WITH HAMZA BELOW
+	'\xC6':	'\u0626',	 // 	ARABIC LETTER YEH WITH HAMZA ABOVE
+	'\xC7':	'\u0627',	 // 	ARABIC LETTER ALEF
+	'\xC8':	'\u0628',	 // 	ARABIC LETTER BEH
+	'\xC9':	'\u0629',	 // 	ARABIC LETTER TEH MARBUTA
+	'\xCA':	'\u062A',	 // 	ARABIC LETTER TEH
+	'\xCB':	'\u062B',	 // 	ARABIC LETTER THEH
+	'\xCC':	'\u062C',	 // 	ARABIC LETTER JEEM
+	'\xCD':	'\u062D',	 // 	ARABIC LETTER HAH
+	'\xCE':	'\u062E',	 // 	ARABIC LETTER KHAH
+	'\xCF':	'\u062F',	 // 	ARABIC LETTER DAL
+	'\xD0':	'\u0630',	 // 	ARABIC LETTER THAL
+	'\xD1':	'\u0631',	 // 	ARABIC LETTER REH
+	'\xD2':	'\u0632',	 // 	ARABIC LETTER ZAIN
+	'\xD3':	'\u0633',	 // 	ARABIC LETTER SEEN
+	'\xD4':	'\u0634',	 // 	ARABIC LETTER SHEEN
+	'\xD5':	'\u0635',	 // 	ARABIC LETTER SAD
+	'\xD6':	'\u0636',	 // 	ARABIC LETTER DAD
+	'\xD7':	'\u0637',	 // 	ARABIC LETTER TAH
+	'\xD8':	'\u0638',	 // 	ARABIC LETTER ZAH
+	'\xD9':	'\u0639',	 // 	ARABIC LETTER AIN
+	'\xDA':	'\u063A',	 // 	ARABIC LETTER GHAIN
+	'\xDB':	undefinedRune,	 // UNDEFINED
+	'\xDC':	undefinedRune,	 // UNDEFINED
+	'\xDD':	undefinedRune,	 // UNDEFINED
+	'\xDE':	undefinedRune,	 // UNDEFINED
+	'\xDF':	undefinedRune,	 // UNDEFINED
+	'\xE0':	'\u0640',	 // 	ARABIC TATWEEL
+	'\xE1':	'\u0641',	 // 	ARABIC LETTER FEH
+	'\xE2':	'\u0642',	 // 	ARABIC LETTER QAF
+	'\xE3':	'\u0643',	 // 	ARABIC LETTER KAF
+	'\xE4':	'\u0644',	 // 	ARABIC LETTER LAM
+	'\xE5':	'\u0645',	 // 	ARABIC LETTER MEEM
+	'\xE6':	'\u0646',	 // 	ARABIC LETTER NOON
+	'\xE7':	'\u0647',	 // 	ARABIC LETTER HEH
+	'\xE8':	'\u0648',	 // 	ARABIC LETTER WAW
+	'\xE9':	'\u0649',	 // 	ARABIC LETTER ALEF MAKSURA
+	'\xEA':	'\u064A',	 // 	ARABIC LETTER YEH
+	'\xEB':	'\u064B',	 // 	ARABIC FATHATAN
+	'\xEC':	'\u064C',	 // 	ARABIC DAMMATAN
+	'\xED':	'\u064D',	 // 	ARABIC KASRATAN
+	'\xEE':	'\u064E',	 // 	ARABIC FATHA
+	'\xEF':	'\u064F',	 // 	ARABIC DAMMA
+	'\xF0':	'\u0650',	 // 	ARABIC KASRA
+	'\xF1':	'\u0651',	 // 	ARABIC SHADDA
+	'\xF2':	'\u0652',	 // 	ARABIC SUKUN
+	'\xF3':	undefinedRune,	 // UNDEFINED
+	'\xF4':	undefinedRune,	 // UNDEFINED
+	'\xF5':	undefinedRune,	 // UNDEFINED
+	'\xF6':	undefinedRune,	 // UNDEFINED
+	'\xF7':	undefinedRune,	 // UNDEFINED
+	'\xF8':	undefinedRune,	 // UNDEFINED
+	'\xF9':	undefinedRune,	 // UNDEFINED
+	'\xFA':	undefinedRune,	 // UNDEFINED
+	'\xFB':	undefinedRune,	 // UNDEFINED
+	'\xFC':	undefinedRune,	 // UNDEFINED
+	'\xFD':	undefinedRune,	 // UNDEFINED
+	'\xFE':	undefinedRune,	 // UNDEFINED
+	'\xFF':	undefinedRune,	 // UNDEFINED
+}
 
+func init() {
+	setBuiltinTable("ISO-8859-6", &tableISO_8859_6)
 }
@@ -0,0 +1,202 @@
+package charmap
+
+import (
+	"encoding/binary"
+	"unicode/utf8"
+)
+
+// simple 8bit codecs definition support
+
+// undefinedRune marks undefined bytes in the decode table of a codecMap8Bit.
+const undefinedRune = utf8.RuneError
+
+// codecMap8Bit implements a single-byte encoding with lookup tables.
+// Decoding is a single array access, which also yields the precomputed UTF-8 form of the byte.
+// Encoding of runes up to U+FFFF uses a two-level table: index selects a page by the high byte
+// of the rune and the page holds the byte+1 (0 if the rune cannot be encoded) by the low byte.
+// If the lower half of the encoding is identical to ASCII, runs of ASCII characters are copied
+// in bulk in both directions.
+type codecMap8Bit struct {
+	ascii   bool
+	decode  [256]rune
+	utf8    [256][utf8.UTFMax]byte
+	utf8Len [256]uint8 // 0 for undefined bytes
+	index   [256]uint16
+	pages   [][256]uint16 // pages[0] is empty
+	astral  map[rune]byte // runes above U+FFFF
+}
+
+// runeByte is an encode-only mapping of a rune to a byte.
+type runeByte struct {
+	r rune
+	b byte
+}
+
+// newCodecMap8Bit builds a codec from a table which maps every byte to a rune,
+// with undefinedRune for undefined bytes.
+// A rune is encoded to the lowest byte which decodes to it, except for the decodeOnly bytes,
+// which are never produced by encoding. encodeOnly adds mappings for runes which
+// the table does not contain; they are used for encoding only.
+func newCodecMap8Bit(table *[256]rune, decodeOnly []byte, encodeOnly []runeByte) *codecMap8Bit {
+	c := &codecMap8Bit{decode: *table, pages: make([][256]uint16, 1, 4)}
+
+	var skip [256]bool
+	for _, b := range decodeOnly {
+		skip[b] = true
+	}
+
+	for i, r := range table {
+		if r == undefinedRune {
+			continue
+		}
+		c.utf8Len[i] = uint8(utf8.EncodeRune(c.utf8[i][:], r))
+
+		if _, ok := c.EncodeRune(r); !ok && !skip[i] {
+			c.setEncode(r, byte(i))
+		}
+	}
+
+	for _, m := range encodeOnly {
+		if _, ok := c.EncodeRune(m.r); !ok {
+			c.setEncode(m.r, m.b)
+		}
+	}
+
+	c.ascii = true
+	for i := 0; i < utf8.RuneSelf; i++ {
+		if table[i] != rune(i) {
+			c.ascii = false
+		}
+	}
+
+	return c
+}
+
+const asciiMask = 0x8080808080808080
+
+// asciiRun returns the length of the run of ASCII characters s starts with,
+// if it is at least 8 bytes long, and 1 otherwise. s must start with an ASCII character.
+func asciiRun(s []byte) int {
+	if len(s) < 8 || binary.LittleEndian.Uint64(s)&asciiMask != 0 {
+		return 1
+	}
+	return asciiPrefix(s)
+}
+
+// asciiPrefix returns the length of the longest prefix of s consisting of ASCII characters.
+// It checks 8 bytes at a time.
+func asciiPrefix(s []byte) int {
+	i := 0
+	for ; i+8 <= len(s); i += 8 {
+		if binary.LittleEndian.Uint64(s[i:])&asciiMask != 0 {
+			break
+		}
+	}
+	for ; i < len(s) && s[i] < utf8.RuneSelf; i++ {
+	}
+	return i
+}
+
+func (c *codecMap8Bit) setEncode(r rune, b byte) {
+	if r > 0xFFFF {
+		if c.astral == nil {
+			c.astral = make(map[rune]byte)
+		}
+		c.astral[r] = b
+		return
+	}
+
+	hi := r >> 8
+	if c.index[hi] == 0 {
+		c.pages = append(c.pages, [256]uint16{})
+		c.index[hi] = uint16(len(c.pages) - 1)
+	}
+	c.pages[c.index[hi]][r&0xFF] = uint16(b) + 1
+}
+
+func (c *codecMap8Bit) EncodeRune(r rune) (byte, bool) {
+	if uint32(r) <= 0xFFFF {
+		v := c.pages[c.index[r>>8]][r&0xFF]
+		return byte(v - 1), v != 0
+	}
+
+	b, ok := c.astral[r]
+	return b, ok
+}
+
+func (c *codecMap8Bit) DecodeByte(b byte) (rune, bool) {
+	r := c.decode[b]
+	return r, r != undefinedRune
+}
+
+func (c *codecMap8Bit) appendDecode(dst, src []byte, o *options) ([]byte, *ConversionError) {
+	var cerr *ConversionError
+
+	for i := 0; i < len(src); i++ {
+		b := src[i]
+		if b < utf8.RuneSelf && c.ascii {
+			if n := asciiRun(src[i:]); n > 1 {
+				dst = append(dst, src[i:i+n]...)
+				i += n - 1
+			} else {
+				dst = append(dst, b)
+			}
+			continue
+		}
+
+		switch n := c.utf8Len[b]; n {
+		case 1:
+			dst = append(dst, c.utf8[b][0])
+			continue
+		case 0:
+		default:
+			dst = append(dst, c.utf8[b][:n]...)
+			continue
+		}
+
+		cerr = cerr.record("decode", i, i, b, 0)
+		if o.decodeErr == nil {
+			break
+		}
+		dst = o.decodeErr(dst, b)
+	}
+
+	return dst, cerr
+}
+
+func (c *codecMap8Bit) appendEncode(dst, src []byte, o *options) ([]byte, *ConversionError) {
+	var cerr *ConversionError
+
+	for i, n := 0, 0; i < len(src); n++ {
+		r, size := rune(src[i]), 1
+		if r < utf8.RuneSelf && c.ascii {
+			if k := asciiRun(src[i:]); k > 1 {
+				dst = append(dst, src[i:i+k]...)
+				i += k
+				n += k - 1
+			} else {
+				dst = append(dst, src[i])
+				i++
+			}
+			continue
+		}
+		if r >= utf8.RuneSelf {
+			r, size = utf8.DecodeRune(src[i:])
+		}
+
+		if b, ok := c.EncodeRune(r); ok {
+			dst = append(dst, b)
+			i += size
+			continue
+		}
+
+		cerr = cerr.record("encode", i, n, 0, r)
+		if o.encodeErr == nil {
+			break
+		}
+		dst = o.encodeErr(dst, c, r)
+		i += size
+	}
+
+	return dst, cerr
+}
@@ -0,0 +1,124 @@
+package charmap
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"golang.org/x/text/unicode/runenames"
+)
+
+//go:generate go run gen_names.go
+
+type runeName struct {
+	r    rune
+	name string
+}
+
+// RuneName returns the Unicode name of r, e.g. "CYRILLIC SMALL LETTER YA", as given in the
+// tables of the builtin encodings or, for other characters, in the Unicode Character Database.
+// Control characters are named by their Unicode 1.0 names, e.g. "LINE FEED", where the tables have them.
+// It returns "" if r has no name, e.g. if it is unassigned or a private use character.
+func RuneName(r rune) string {
+	i := sort.Search(len(runeNames), func(i int) bool { return runeNames[i].r >= r })
+	if i < len(runeNames) && runeNames[i].r == r {
+		return runeNames[i].name
+	}
+	return unicodeName(r)
+}
+
+// unicodeName returns the name of r from the Unicode Character Database. Where runenames
+// has a range label such as "<CJK Ideograph>", the name is derived as described in
+// section 4.8 of the Unicode Standard, or "" is returned if the character has no name.
+func unicodeName(r rune) string {
+	name := runenames.Name(r)
+	if !strings.HasPrefix(name, "<") {
+		return name
+	}
+
+	switch {
+	case strings.HasPrefix(name, "<CJK Ideograph"):
+		return fmt.Sprintf("CJK UNIFIED IDEOGRAPH-%04X", r)
+	case strings.HasPrefix(name, "<Tangut Ideograph"):
+		return fmt.Sprintf("TANGUT IDEOGRAPH-%04X", r)
+	case name == "<Hangul Syllable>":
+		return hangulName(r)
+	}
+	return ""
+}
+
+var (
+	jamoL = [...]string{"G", "GG", "N", "D", "DD", "R", "M", "B", "BB", "S", "SS", "", "J", "JJ", "C", "K", "T", "P", "H"}
+	jamoV = [...]string{"A", "AE", "YA", "YAE", "EO", "E", "YEO", "YE", "O", "WA", "WAE", "OE", "YO", "U", "WEO", "WE", "WI", "YU", "EU", "YI", "I"}
+	jamoT = [...]string{"", "G", "GG", "GS", "N", "NJ", "NH", "D", "L", "LG", "LM", "LB", "LS", "LT", "LP", "LH", "M", "B", "BS", "S", "SS", "NG", "J", "C", "K", "T", "P", "H"}
+)
+
+// hangulName returns the name of a precomposed Hangul syllable, e.g. "HANGUL SYLLABLE GA" for U+AC00.
+func hangulName(r rune) string {
+	s := int(r - 0xAC00)
+	l, v, t := s/(len(jamoV)*len(jamoT)), s%(len(jamoV)*len(jamoT))/len(jamoT), s%len(jamoT)
+	return "HANGUL SYLLABLE " + jamoL[l] + jamoV[v] + jamoT[t]
+}
+
+// DescribeByte returns the rune which the byte b decodes to in the specified encoding
+// and its name (see RuneName). ok is false if the encoding is unknown or b is undefined in it.
+func DescribeByte(encoding string, b byte) (r rune, name string, ok bool) {
+	e, err := Lookup(encoding)
+	if err != nil {
+		return 0, "", false
+	}
+
+	return e.DescribeByte(b)
+}
+
+// DescribeByte returns the rune which the byte b decodes to and its name, see the DescribeByte function.
+func (e *Encoding) DescribeByte(b byte) (r rune, name string, ok bool) {
+	r, ok = e.codec.DecodeByte(b)
+	if !ok {
+		return 0, "", false
+	}
+	return r, RuneName(r), true
+}
+
+// ByteMapping is a byte of an encoding and the rune it decodes to.
+type ByteMapping struct {
+	Encoding string
+	Byte     byte
+	Rune     rune
+}
+
+// FindByName returns every encoding and byte which decode to the character with the
+// specified name, e.g. FindByName("CYRILLIC SMALL LETTER YA"). The case of the name does not matter.
+// The result is sorted by encoding name and byte.
+func FindByName(name string) []ByteMapping {
+	var runes []rune
+	for _, rn := range runeNames {
+		if strings.EqualFold(rn.name, name) {
+			runes = append(runes, rn.r)
+		}
+	}
+	if len(runes) == 0 {
+		return nil
+	}
+
+	var list []ByteMapping
+	for _, encoding := range List() {
+		e, err := Lookup(encoding)
+		if err != nil {
+			continue
+		}
+
+		for i := 0; i < 256; i++ {
+			r, ok := e.codec.DecodeByte(byte(i))
+			if !ok {
+				continue
+			}
+			for _, want := range runes {
+				if r == want {
+					list = append(list, ByteMapping{Encoding: encoding, Byte: byte(i), Rune: r})
+				}
+			}
+		}
+	}
+	return list
+}
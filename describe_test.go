@@ -0,0 +1,92 @@
+package charmap
+
+import (
+	"testing"
+)
+
+func TestDescribeByte(t *testing.T) {
+	requireEncodings(t, "cp1251")
+
+	r, name, ok := DescribeByte("cp1251", 0xFF)
+	if !ok || r != 'я' || name != "CYRILLIC SMALL LETTER YA" {
+		t.Error("describing cp1251 0xFF: wrong result")
+	}
+
+	if compiledIn("cp437") {
+		r, name, ok = DescribeByte("cp437", 0x91)
+		if !ok || r != 'æ' || name != "LATIN SMALL LETTER AE" {
+			t.Error("describing cp437 0x91: wrong result")
+		}
+	}
+
+	if _, _, ok := DescribeByte("cp1251", 0x98); ok {
+		t.Error("describing undefined byte: wrong result")
+	}
+	if _, _, ok := DescribeByte("wrong-encoding", 0x41); ok {
+		t.Error("describing byte of wrong-encoding: wrong result")
+	}
+
+	// every byte of every builtin encoding has a name
+	for _, b := range builtins {
+		if b.table == nil {
+			continue
+		}
+		for i, r := range b.table {
+			if r != undefinedRune && RuneName(r) == "" {
+				t.Errorf("%s byte 0x%02X: %U has no name", b.name, i, r)
+			}
+		}
+	}
+
+	// characters which are in no table are named from the Unicode Character Database
+	names := map[rune]string{
+		'₽':          "RUBLE SIGN",
+		'\U0001F600': "GRINNING FACE",
+		'世':          "CJK UNIFIED IDEOGRAPH-4E16",
+		'가':          "HANGUL SYLLABLE GA",
+		'힣':          "HANGUL SYLLABLE HIH",
+		'\u0378':     "",
+		'\uE000':     "",
+	}
+	for r, name := range names {
+		if RuneName(r) != name {
+			t.Errorf("name of %U: got %q, want %q", r, RuneName(r), name)
+		}
+	}
+}
+
+func TestFindByName(t *testing.T) {
+	requireEncodings(t, "cp1251")
+
+	list := FindByName("cyrillic small letter ya")
+	want := map[string]byte{"CP1251": 0xFF, "CP866": 0xEF, "KOI8-R": 0xD1, "ISO-8859-5": 0xEF}
+	for name := range want {
+		if !compiledIn(name) {
+			delete(want, name)
+		}
+	}
+	found := 0
+	for _, m := range list {
+		if m.Rune != 'я' {
+			t.Errorf("find cyrillic small letter ya: wrong rune in %v", m)
+		}
+		if b, ok := want[m.Encoding]; ok {
+			if m.Byte != b {
+				t.Errorf("find cyrillic small letter ya: wrong byte in %v", m)
+			}
+			found++
+		}
+	}
+	if found != len(want) {
+		t.Error("find cyrillic small letter ya: encodings are missing")
+	}
+	for i := 1; i < len(list); i++ {
+		if list[i-1].Encoding > list[i].Encoding {
+			t.Error("find cyrillic small letter ya: result is not sorted")
+		}
+	}
+
+	if FindByName("NO SUCH CHARACTER") != nil {
+		t.Error("find unknown name: wrong result")
+	}
+}
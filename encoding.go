@@ -0,0 +1,134 @@
+package charmap
+
+import (
+	"sort"
+	"sync"
+)
+
+// Encoding is a handle to one of the supported encodings.
+// It is obtained once with Lookup and can be reused to convert data
+// without resolving the encoding name on every call.
+// An Encoding is safe for concurrent use.
+type Encoding struct {
+	name    string
+	builtin *builtin // nil for registered codecs
+	once    sync.Once
+	codec   Codec
+}
+
+// Lookup returns the encoding with the specified name or alias.
+// Names are resolved in the same way as in Encode and Decode.
+// If the specified encoding is unknown, it will return ErrUnknownEncoding, or an
+// *UnknownEncodingError wrapping it if there are encodings with similar names.
+// For builtin encodings which are excluded by build tags the error wraps ErrUnknownEncoding too.
+func Lookup(name string) (*Encoding, error) {
+	canonical := getCodecForEncoding(name)
+
+	registryMu.RLock()
+	e, ok := codecsMap[canonical]
+	registryMu.RUnlock()
+
+	if ok {
+		e.once.Do(e.build)
+		return e, nil
+	}
+
+	return nil, unknownEncodingError(name)
+}
+
+// unknownEncodingError returns the error for an encoding name which is not registered.
+func unknownEncodingError(name string) error {
+	if err := excludedError(normalizeName(name)); err != nil {
+		return err
+	}
+
+	registryMu.RLock()
+	suggestions := suggestEncodings(name)
+	registryMu.RUnlock()
+
+	if len(suggestions) > 0 {
+		return &UnknownEncodingError{Name: name, Suggestions: suggestions}
+	}
+	return ErrUnknownEncoding
+}
+
+// build creates the codec of a builtin encoding from its table.
+func (e *Encoding) build() {
+	if e.builtin != nil {
+		e.codec = newCodecMap8Bit(e.builtin.table, e.builtin.decodeOnly, e.builtin.encodeOnly)
+	}
+}
+
+// Name returns the canonical name of the encoding.
+func (e *Encoding) Name() string {
+	return e.name
+}
+
+// Aliases returns the sorted list of alternative names of the encoding.
+func (e *Encoding) Aliases() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	list := make([]string, 0)
+	for alias, name := range aliasesMap {
+		if name == e.name {
+			list = append(list, alias)
+		}
+	}
+	sort.Strings(list)
+	return list
+}
+
+// Codec returns the codec which implements the encoding.
+func (e *Encoding) Codec() Codec {
+	return e.codec
+}
+
+// String returns the canonical name of the encoding.
+func (e *Encoding) String() string {
+	return e.name
+}
+
+// Encode converts a string from UTF-8 to the encoding, see the Encode function.
+func (e *Encoding) Encode(data string, opts ...Option) (string, error) {
+	result, cerr := mapRunesToBytes(e.codec, make([]byte, 0, len(data)), []byte(data), newOptions(opts))
+	return string(result), e.conversionError(cerr)
+}
+
+// Decode converts a string from the encoding to UTF-8, see the Decode function.
+func (e *Encoding) Decode(data string, opts ...Option) (string, error) {
+	result, cerr := mapBytesToRunes(e.codec, make([]byte, 0, len(data)), []byte(data), newOptions(opts))
+	return string(result), e.conversionError(cerr)
+}
+
+// EncodeBytes is like Encode but converts a byte slice.
+func (e *Encoding) EncodeBytes(data []byte, opts ...Option) ([]byte, error) {
+	return e.AppendEncode(make([]byte, 0, len(data)), data, opts...)
+}
+
+// DecodeBytes is like Decode but converts a byte slice.
+func (e *Encoding) DecodeBytes(data []byte, opts ...Option) ([]byte, error) {
+	return e.AppendDecode(make([]byte, 0, len(data)), data, opts...)
+}
+
+// AppendEncode converts src from UTF-8 to the encoding and appends the result to dst, see the AppendEncode function.
+func (e *Encoding) AppendEncode(dst, src []byte, opts ...Option) ([]byte, error) {
+	result, cerr := mapRunesToBytes(e.codec, dst, src, newOptions(opts))
+	return result, e.conversionError(cerr)
+}
+
+// AppendDecode converts src from the encoding to UTF-8 and appends the result to dst, see the AppendDecode function.
+func (e *Encoding) AppendDecode(dst, src []byte, opts ...Option) ([]byte, error) {
+	result, cerr := mapBytesToRunes(e.codec, dst, src, newOptions(opts))
+	return result, e.conversionError(cerr)
+}
+
+// conversionError fills in the encoding name. It returns a nil error if cerr is nil.
+func (e *Encoding) conversionError(cerr *ConversionError) error {
+	if cerr == nil {
+		return nil
+	}
+
+	cerr.Encoding = e.name
+	return cerr
+}
@@ -0,0 +1,96 @@
+package charmap
+
+import (
+	"testing"
+)
+
+func TestLookup(t *testing.T) {
+	requireEncodings(t, "cp1251")
+
+	pana_cp1251 := "\xC2\x20\xF7\xE0\xF9\xE0\xF5\x20\xFE\xE3\xE0"
+	pana_utf8 := "В чащах юга"
+
+	enc, err := Lookup("windows_1251")
+	if err != nil {
+		t.Fatal("lookup windows_1251: wrong error value")
+	}
+	if enc.Name() != "CP1251" {
+		t.Error("lookup windows_1251: wrong name")
+	}
+
+	aliases := enc.Aliases()
+	if len(aliases) != 5 || aliases[0] != "1251" || aliases[3] != "WINDOWS-1251" {
+		t.Error("lookup windows_1251: wrong aliases")
+	}
+
+	test_encode, err := enc.Encode(pana_utf8)
+	if err != nil || test_encode != pana_cp1251 {
+		t.Error("encoding with handle: wrong result")
+	}
+
+	test_decode, err := enc.DecodeBytes([]byte(pana_cp1251))
+	if err != nil || string(test_decode) != pana_utf8 {
+		t.Error("decoding bytes with handle: wrong result")
+	}
+
+	again, _ := Lookup("cp-1251")
+	if again != enc {
+		t.Error("lookup cp-1251: handle is not shared")
+	}
+
+	_, err = Lookup("wrong-encoding")
+	if err != ErrUnknownEncoding {
+		t.Error("lookup wrong-encoding: wrong error value")
+	}
+}
+
+func TestLookupLabels(t *testing.T) {
+	labels := map[string]string{
+		"latin1":          "ISO-8859-1",
+		"ISO_8859-1:1987": "ISO-8859-1",
+		" iso 8859 1 ":    "ISO-8859-1",
+		"csISOLatin1":     "ISO-8859-1",
+		"IBM819":          "ISO-8859-1",
+		"IBM437":          "CP437",
+		"ibm866":          "CP866",
+		"x-mac-cyrillic":  "MAC-CYRILLIC",
+		"mac_cyrillic":    "MAC-CYRILLIC",
+		"macintosh":       "MAC-ROMAN",
+		"Cp1251":          "CP1251",
+		"x-cp1252":        "CP1252",
+		"windows 1250":    "CP1250",
+		"koi8_r":          "KOI8-R",
+		"Latin-9":         "ISO-8859-15",
+		"l10":             "ISO-8859-16",
+		"tis-620":         "ISO-8859-11",
+		"tis_620_0":       "ISO-8859-11",
+		"iso_ir_166":      "ISO-8859-11",
+		"latin_1":         "ISO-8859-1",
+		"latin":           "ISO-8859-1",
+		"8859":            "ISO-8859-1",
+		"iso_8859_1_1987": "ISO-8859-1",
+		"iso_8859_5_1988": "ISO-8859-5",
+		"iso_8859_8_1988": "ISO-8859-8",
+		"dos-874":         "CP874",
+	}
+	for label, name := range labels {
+		if !compiledIn(name) {
+			continue
+		}
+		enc, err := Lookup(label)
+		if err != nil || enc.Name() != name {
+			t.Errorf("lookup %q: got %v, %v, want %s", label, enc, err, name)
+		}
+	}
+
+	// no two builtin encodings share a name or alias
+	keys := make(map[string]string)
+	for _, b := range builtins {
+		for _, name := range append([]string{b.name}, b.aliases...) {
+			if other, ok := keys[lookupKey(name)]; ok && other != b.name {
+				t.Errorf("%s of %s is used by %s", name, b.name, other)
+			}
+			keys[lookupKey(name)] = b.name
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package charmap
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ConversionError is returned when the input contains characters which cannot be converted.
+// It describes the first such character and the total number of substitutions.
+// ConversionError wraps ErrInvalidCodepoint, so errors.Is(err, ErrInvalidCodepoint) reports true.
+type ConversionError struct {
+	Encoding   string // canonical name of the encoding, the target one when transcoding
+	Op         string // "encode", "decode" or "transcode"
+	Offset     int64  // byte offset of the first failure in the input
+	RuneOffset int64  // character offset of the first failure in the input
+	Byte       byte   // the first undefined byte, when decoding or transcoding
+	Rune       rune   // the first rune which cannot be encoded, when encoding or transcoding
+	Count      int    // total number of characters which could not be converted
+}
+
+func (e *ConversionError) Error() string {
+	var what string
+	switch e.Op {
+	case "decode":
+		what = fmt.Sprintf("cannot decode byte 0x%02X from %s", e.Byte, e.Encoding)
+	case "transcode":
+		what = fmt.Sprintf("cannot transcode byte 0x%02X (%U) to %s", e.Byte, e.Rune, e.Encoding)
+	default:
+		what = fmt.Sprintf("cannot encode %U %q to %s", e.Rune, e.Rune, e.Encoding)
+	}
+
+	if e.Count > 1 {
+		return fmt.Sprintf("%s at offset %d (and %d more)", what, e.Offset, e.Count-1)
+	}
+	return fmt.Sprintf("%s at offset %d", what, e.Offset)
+}
+
+func (e *ConversionError) Unwrap() error {
+	return ErrInvalidCodepoint
+}
+
+// record registers a character which could not be converted. It allocates
+// the error for the first failure, which is described by the arguments.
+func (e *ConversionError) record(op string, offset, runeOffset int, b byte, r rune) *ConversionError {
+	if e == nil {
+		e = &ConversionError{Op: op, Offset: int64(offset), RuneOffset: int64(runeOffset), Byte: b, Rune: r}
+	}
+	e.Count++
+	return e
+}
+
+// add merges the error of the next part of a stream, which starts at the given offsets.
+func (e *ConversionError) add(next *ConversionError, offset, runeOffset int64) *ConversionError {
+	if next == nil {
+		return e
+	}
+	if e != nil {
+		e.Count += next.Count
+		return e
+	}
+
+	next.Offset += offset
+	next.RuneOffset += runeOffset
+	return next
+}
+
+// UnknownEncodingError is returned instead of ErrUnknownEncoding when the unknown
+// encoding name is similar to the names or aliases of supported encodings.
+// It wraps ErrUnknownEncoding, so errors.Is(err, ErrUnknownEncoding) reports true.
+type UnknownEncodingError struct {
+	Name        string   // the name as it was given
+	Suggestions []string // canonical names of the closest encodings, best first
+}
+
+func (e *UnknownEncodingError) Error() string {
+	return fmt.Sprintf("%v: %s (did you mean %s?)", ErrUnknownEncoding, e.Name, strings.Join(e.Suggestions, ", "))
+}
+
+func (e *UnknownEncodingError) Unwrap() error {
+	return ErrUnknownEncoding
+}
+
+// maxSuggestions limits the number of names in an UnknownEncodingError.
+const maxSuggestions = 3
+
+// suggestEncodings returns the canonical names of the encodings whose name or alias
+// is closest to name. Names which differ too much are not suggested.
+// The number in a name, such as the code page, is weighted: a name with the same number
+// of at least 3 digits is suggested first (DOS-866 for CP866), and a name whose number
+// differs by more than one digit is not suggested (ISO-IR-6 for ISO-IR-166).
+// registryMu must be held.
+func suggestEncodings(name string) []string {
+	key := lookupKey(name)
+	num := keyNumber(key)
+	maxDist := 2
+	if len(key) < 6 {
+		maxDist = 1
+	}
+
+	dist := make(map[string]int)
+	for k, canonical := range keysMap {
+		d := editDistance(key, k)
+		if kn := keyNumber(k); num != "" && kn != "" {
+			if num == kn && len(num) >= 3 {
+				d = 0
+			} else if editDistance(num, kn) > 1 {
+				continue
+			}
+		}
+		if d > maxDist {
+			continue
+		}
+		if old, ok := dist[canonical]; !ok || d < old {
+			dist[canonical] = d
+		}
+	}
+
+	list := make([]string, 0, len(dist))
+	for canonical := range dist {
+		list = append(list, canonical)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if dist[list[i]] != dist[list[j]] {
+			return dist[list[i]] < dist[list[j]]
+		}
+		return list[i] < list[j]
+	})
+	if len(list) > maxSuggestions {
+		list = list[:maxSuggestions]
+	}
+	return list
+}
+
+// keyNumber returns the digits of a lookup key, e.g. "866" for "DOS866".
+func keyNumber(key string) string {
+	return strings.Map(func(r rune) rune {
+		if r < '0' || r > '9' {
+			return -1
+		}
+		return r
+	}, key)
+}
+
+// editDistance returns the optimal string alignment distance between a and b:
+// the number of insertions, deletions, substitutions and transpositions of
+// adjacent bytes needed to turn a into b.
+func editDistance(a, b string) int {
+	// rows i-2, i-1 and i of the distance matrix
+	prev2 := make([]int, len(b)+1)
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				cur[j] = min(cur[j], prev2[j-2]+1)
+			}
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	return prev[len(b)]
+}
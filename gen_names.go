@@ -0,0 +1,92 @@
+//go:build ignore
+
+// gen_names collects the character names from the comments of the codec-*.go files
+// and writes them to names.go. Run it with go generate.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// a table line such as: '\xC0':	'\u0410',	 // CYRILLIC CAPITAL LETTER A
+var lineRe = regexp.MustCompile(`^\s*'\\x[0-9a-fA-F]{2}':\s*'\\u([0-9a-fA-F]{4})',\s*//(.*)$`)
+
+func main() {
+	files, err := filepath.Glob("codec-*.go")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	names := make(map[rune]string)
+	for _, file := range files {
+		if err := collect(file, names); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	runes := make([]rune, 0, len(names))
+	for r := range names {
+		runes = append(runes, r)
+	}
+	sort.Slice(runes, func(i, j int) bool { return runes[i] < runes[j] })
+
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "// Code generated by gen_names.go from the comments of the codec-*.go files; DO NOT EDIT.")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "package charmap")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "// runeNames holds the names of the characters of the builtin encodings, sorted by rune.")
+	fmt.Fprintln(&buf, "var runeNames = [...]runeName{")
+	for _, r := range runes {
+		fmt.Fprintf(&buf, "\t{0x%04X, %q},\n", r, names[r])
+	}
+	fmt.Fprintln(&buf, "}")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("names.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func collect(file string, names map[rune]string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	line := 0
+	for scanner.Scan() {
+		line++
+
+		m := lineRe.FindStringSubmatch(scanner.Text())
+		if m == nil {
+			continue
+		}
+		n, err := strconv.ParseUint(m[1], 16, 32)
+		if err != nil {
+			return fmt.Errorf("%s:%d: %v", file, line, err)
+		}
+		r, name := rune(n), strings.TrimSpace(m[2])
+
+		if old, ok := names[r]; ok && old != name {
+			return fmt.Errorf("%s:%d: %U is named %q, elsewhere %q", file, line, r, name, old)
+		}
+		names[r] = name
+	}
+	return scanner.Err()
+}
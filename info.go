@@ -0,0 +1,191 @@
+package charmap
+
+import (
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Family is a group of related encodings.
+type Family string
+
+const (
+	FamilyWindows Family = "Windows"
+	FamilyDOS     Family = "DOS"
+	FamilyISO     Family = "ISO"
+	FamilyMac     Family = "Mac"
+	FamilyKOI     Family = "KOI"
+)
+
+// families lists the families in the order used by ListFamilies.
+var families = []Family{FamilyWindows, FamilyDOS, FamilyISO, FamilyMac, FamilyKOI}
+
+// ListFamilies returns the families which have at least one supported encoding.
+func ListFamilies() []Family {
+	list := make([]Family, 0, len(families))
+	for _, f := range families {
+		if len(ListByFamily(f)) > 0 {
+			list = append(list, f)
+		}
+	}
+	return list
+}
+
+// ListByFamily returns a sorted list of the supported encodings of the family.
+func ListByFamily(f Family) []string {
+	return listBuiltins(func(b *builtin) bool {
+		return b.family == f
+	})
+}
+
+// ListByScript returns a sorted list of the supported encodings for the script,
+// which is a Unicode script name as used in the unicode package, e.g. "Cyrillic" or "Greek".
+// The case of the script name does not matter.
+func ListByScript(script string) []string {
+	return listBuiltins(func(b *builtin) bool {
+		for _, s := range b.scripts {
+			if strings.EqualFold(s, script) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// listBuiltins returns a sorted list of the registered builtin encodings which match.
+func listBuiltins(match func(b *builtin) bool) []string {
+	loadRegistry()
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	list := make([]string, 0)
+	for name, e := range codecsMap {
+		if e.builtin != nil && match(e.builtin) {
+			list = append(list, name)
+		}
+	}
+	sort.Strings(list)
+	return list
+}
+
+// EncodingInfo describes an encoding.
+// Numeric identifiers are 0 if the encoding has none. For encodings added with Register
+// only Name, Aliases, Defined and ASCII are set.
+type EncodingInfo struct {
+	Name      string
+	Aliases   []string
+	Family    Family
+	Scripts   []string // Unicode script names as used in the unicode package, e.g. "Cyrillic"
+	Languages []string // ISO 639-1 language codes
+	MIBenum   int      // IANA character set MIBenum
+	CodePage  int      // Windows code page number
+	CCSID     int      // IBM Coded Character Set Identifier
+	Defined   int      // number of defined bytes
+	ASCII     bool     // the lower half of the encoding is identical to ASCII
+}
+
+// Info returns the description of the encoding with the specified name or alias.
+// If the specified encoding is unknown, it will return ErrUnknownEncoding
+func Info(name string) (EncodingInfo, error) {
+	e, err := Lookup(name)
+	if err != nil {
+		return EncodingInfo{}, err
+	}
+
+	return e.Info(), nil
+}
+
+// Info returns the description of the encoding.
+func (e *Encoding) Info() EncodingInfo {
+	info := EncodingInfo{
+		Name:     e.name,
+		Aliases:  e.Aliases(),
+		MIBenum:  e.MIBenum(),
+		CodePage: e.CodePage(),
+		CCSID:    e.CCSID(),
+		ASCII:    true,
+	}
+
+	if b := e.builtin; b != nil {
+		info.Family = b.family
+		info.Scripts = append([]string(nil), b.scripts...)
+		info.Languages = append([]string(nil), b.languages...)
+	}
+
+	for i := 0; i < 256; i++ {
+		r, ok := e.codec.DecodeByte(byte(i))
+		if ok {
+			info.Defined++
+		}
+		if i < utf8.RuneSelf && (!ok || r != rune(i)) {
+			info.ASCII = false
+		}
+	}
+
+	return info
+}
+
+// LookupCodePage returns the encoding with the specified Windows code page number,
+// e.g. 1251 for CP1251 or 20866 for KOI8-R.
+// If there is no such encoding, it will return ErrUnknownEncoding
+func LookupCodePage(cp int) (*Encoding, error) {
+	return lookupNumber(cp, (*Encoding).CodePage)
+}
+
+// LookupMIBenum returns the encoding with the specified IANA MIBenum, e.g. 2084 for KOI8-R.
+// If there is no such encoding, it will return ErrUnknownEncoding
+func LookupMIBenum(mib int) (*Encoding, error) {
+	return lookupNumber(mib, (*Encoding).MIBenum)
+}
+
+// LookupCCSID returns the encoding with the specified IBM CCSID, e.g. 878 for KOI8-R.
+// If there is no such encoding, it will return ErrUnknownEncoding
+func LookupCCSID(ccsid int) (*Encoding, error) {
+	return lookupNumber(ccsid, (*Encoding).CCSID)
+}
+
+func lookupNumber(n int, number func(e *Encoding) int) (*Encoding, error) {
+	if n <= 0 {
+		return nil, ErrUnknownEncoding
+	}
+
+	loadRegistry()
+	registryMu.RLock()
+	var found *Encoding
+	for _, e := range codecsMap {
+		if number(e) == n {
+			found = e
+			break
+		}
+	}
+	registryMu.RUnlock()
+
+	if found == nil {
+		return nil, ErrUnknownEncoding
+	}
+	return Lookup(found.name)
+}
+
+// CodePage returns the Windows code page number of the encoding, or 0 if it has none.
+func (e *Encoding) CodePage() int {
+	if e.builtin == nil {
+		return 0
+	}
+	return e.builtin.codePage
+}
+
+// MIBenum returns the IANA MIBenum of the encoding, or 0 if it has none.
+func (e *Encoding) MIBenum() int {
+	if e.builtin == nil {
+		return 0
+	}
+	return e.builtin.mib
+}
+
+// CCSID returns the IBM Coded Character Set Identifier of the encoding, or 0 if it has none.
+func (e *Encoding) CCSID() int {
+	if e.builtin == nil {
+		return 0
+	}
+	return e.builtin.ccsid
+}
@@ -0,0 +1,140 @@
+package charmap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestInfo(t *testing.T) {
+	requireEncodings(t, "cp1251", "cp864", "koi8-r")
+
+	info, err := Info("windows-1251")
+	if err != nil {
+		t.Fatal("info for windows-1251: wrong error value")
+	}
+	if info.Name != "CP1251" || info.Family != FamilyWindows || !reflect.DeepEqual(info.Scripts, []string{"Cyrillic"}) {
+		t.Error("info for windows-1251: wrong description")
+	}
+	if !reflect.DeepEqual(info.Aliases, []string{"1251", "CP-1251", "CSWINDOWS1251", "WINDOWS-1251", "X-CP1251"}) {
+		t.Error("info for windows-1251: wrong aliases")
+	}
+	if info.MIBenum != 2251 || info.CodePage != 1251 || info.CCSID != 1251 {
+		t.Error("info for windows-1251: wrong identifiers")
+	}
+	if info.Defined != 255 || !info.ASCII {
+		t.Error("info for windows-1251: wrong table properties")
+	}
+
+	info, _ = Info("cp864")
+	if info.Family != FamilyDOS || info.ASCII {
+		t.Error("info for cp864: wrong description")
+	}
+
+	info, _ = Info("koi8-r")
+	if info.Family != FamilyKOI || info.Defined != 256 || info.CodePage != 20866 {
+		t.Error("info for koi8-r: wrong description")
+	}
+
+	for _, b := range builtins {
+		if b.family == "" || len(b.scripts) == 0 || len(b.languages) == 0 {
+			t.Errorf("info for %s: missing description", b.name)
+		}
+	}
+
+	_, err = Info("wrong-encoding")
+	if err != ErrUnknownEncoding {
+		t.Error("info for wrong-encoding: wrong error value")
+	}
+}
+
+func TestLookupNumber(t *testing.T) {
+	if compiledIn("cp1251") {
+		e, err := LookupCodePage(1251)
+		if err != nil || e.Name() != "CP1251" {
+			t.Error("lookup code page 1251: wrong result")
+		}
+	}
+	if compiledIn("koi8-r") {
+		e, err := LookupCodePage(20866)
+		if err != nil || e.Name() != "KOI8-R" {
+			t.Error("lookup code page 20866: wrong result")
+		}
+		e, err = LookupMIBenum(2084)
+		if err != nil || e.Name() != "KOI8-R" {
+			t.Error("lookup MIBenum 2084: wrong result")
+		}
+	}
+	if compiledIn("iso-8859-1") {
+		e, err := LookupCCSID(819)
+		if err != nil || e.Name() != "ISO-8859-1" || e.CodePage() != 28591 || e.MIBenum() != 4 {
+			t.Error("lookup CCSID 819: wrong result")
+		}
+	}
+	if compiledIn("tis-620") {
+		e, err := LookupMIBenum(2259)
+		if err != nil || e.Name() != "ISO-8859-11" {
+			t.Error("lookup MIBenum 2259: wrong result")
+		}
+	}
+
+	for _, n := range []int{0, -1, 65001} {
+		if _, err := LookupCodePage(n); err != ErrUnknownEncoding {
+			t.Errorf("lookup code page %d: wrong error value", n)
+		}
+	}
+
+	// every number identifies one encoding
+	for _, name := range List() {
+		e, _ := Lookup(name)
+		for _, lookup := range []struct {
+			n int
+			f func(int) (*Encoding, error)
+		}{{e.CodePage(), LookupCodePage}, {e.MIBenum(), LookupMIBenum}, {e.CCSID(), LookupCCSID}} {
+			if lookup.n == 0 {
+				continue
+			}
+			if e2, err := lookup.f(lookup.n); err != nil || e2 != e {
+				t.Errorf("lookup %d of %s: got %v, %v", lookup.n, name, e2, err)
+			}
+		}
+	}
+}
+
+func TestListFilters(t *testing.T) {
+	// families excluded by build tags are left out
+	compiled := make(map[Family]bool)
+	for _, b := range builtins {
+		if b.table != nil {
+			compiled[b.family] = true
+		}
+	}
+	families := []Family{}
+	for _, f := range []Family{FamilyWindows, FamilyDOS, FamilyISO, FamilyMac, FamilyKOI} {
+		if compiled[f] {
+			families = append(families, f)
+		}
+	}
+	if !reflect.DeepEqual(ListFamilies(), families) {
+		t.Error("list families: wrong result")
+	}
+	koi, cyrillic := []string{}, []string{}
+	for _, name := range []string{"KOI8-R", "KOI8-U"} {
+		if compiledIn(name) {
+			koi = append(koi, name)
+		}
+	}
+	for _, name := range []string{"CP1251", "CP866", "ISO-8859-5", "KOI8-R", "KOI8-U", "MAC-CYRILLIC"} {
+		if compiledIn(name) {
+			cyrillic = append(cyrillic, name)
+		}
+	}
+	if !reflect.DeepEqual(ListByFamily(FamilyKOI), koi) {
+		t.Error("list koi family: wrong result")
+	}
+	if !reflect.DeepEqual(ListByScript("cyrillic"), cyrillic) {
+		t.Error("list cyrillic script: wrong result")
+	}
+	if len(ListByScript("Klingon")) != 0 {
+		t.Error("list unknown script: wrong result")
+	}
+}
@@ -0,0 +1,99 @@
+package charmap
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ParseUnicodeMapping reads an 8bit encoding definition in the format of
+// the Unicode.org mapping files (MAPPINGS/VENDORS) and returns a Codec for it.
+// Every line maps a byte to a code point, e.g. "0xC0<TAB>0x0410<TAB>#CYRILLIC CAPITAL LETTER A".
+// Comment lines and bytes without a code point ("0x98<TAB><TAB>#UNDEFINED") are accepted;
+// bytes which are not listed are undefined.
+// Bytes mapped to a sequence of code points, as in the Apple tables ("0xA0<TAB>0x0041+0x030A"),
+// cannot be represented by a single byte codec and are left undefined. The direction hints
+// of the Apple tables ("<LR>+0x0020") are ignored.
+// The result can be added to the list of supported encodings with Register.
+func ParseUnicodeMapping(r io.Reader) (Codec, error) {
+	var table [256]rune
+	for i := range table {
+		table[i] = utf8.RuneError
+	}
+	var seen [256]bool
+
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+
+		text := scanner.Text()
+		if i := strings.IndexByte(text, '#'); i >= 0 {
+			text = text[:i]
+		}
+		fields := strings.Fields(text)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) > 2 {
+			return nil, fmt.Errorf("charmap: mapping line %d: unexpected field %q", line, fields[2])
+		}
+
+		b, err := parseHex(fields[0], 0xFF)
+		if err != nil {
+			return nil, fmt.Errorf("charmap: mapping line %d: invalid byte %q", line, fields[0])
+		}
+		if seen[b] {
+			return nil, fmt.Errorf("charmap: mapping line %d: byte %s is defined twice", line, fields[0])
+		}
+		seen[b] = true
+
+		if len(fields) == 1 {
+			// undefined byte
+			continue
+		}
+
+		codepoints := fields[1]
+		if strings.HasPrefix(codepoints, "<") {
+			if i := strings.Index(codepoints, ">+"); i > 0 {
+				codepoints = codepoints[i+2:]
+			}
+		}
+
+		var r uint64
+		for i, s := range strings.Split(codepoints, "+") {
+			if r, err = parseHex(s, utf8.MaxRune); err != nil || !utf8.ValidRune(rune(r)) {
+				return nil, fmt.Errorf("charmap: mapping line %d: invalid code point %q", line, fields[1])
+			}
+			if i > 0 {
+				// code point sequence
+				r = utf8.RuneError
+			}
+		}
+		table[b] = rune(r)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return NewSingleByteCodec(table), nil
+}
+
+// parseHex parses a number in the 0xNN notation not greater than max.
+func parseHex(s string, max uint64) (uint64, error) {
+	if len(s) < 3 || s[0] != '0' || (s[1] != 'x' && s[1] != 'X') {
+		return 0, strconv.ErrSyntax
+	}
+
+	n, err := strconv.ParseUint(s[2:], 16, 32)
+	if err != nil {
+		return 0, err
+	}
+	if n > max {
+		return 0, strconv.ErrRange
+	}
+	return n, nil
+}
@@ -0,0 +1,62 @@
+package charmap
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestParseUnicodeMapping(t *testing.T) {
+	mapping := `#
+#    Name:     test to Unicode table
+#
+#    Format: Three tab-separated columns
+#
+0x41	0x0041	#LATIN CAPITAL LETTER A
+0x42	0x0042	#LATIN CAPITAL LETTER B
+0x80	0x0402	#CYRILLIC CAPITAL LETTER DJE
+0x98	      	#UNDEFINED
+0x99	0x0041+0x030A	#LATIN CAPITAL LETTER A + COMBINING RING ABOVE
+0x9A	<LR>+0x0025	#PERCENT SIGN, left-right
+0xFF	0x044F	#CYRILLIC SMALL LETTER YA
+`
+
+	c, err := ParseUnicodeMapping(strings.NewReader(mapping))
+	if err != nil {
+		t.Fatal("parsing mapping: wrong error value")
+	}
+
+	if r, ok := c.DecodeByte(0x80); !ok || r != 'Ђ' {
+		t.Error("parsing mapping: wrong decoded rune")
+	}
+	if b, ok := c.EncodeRune('я'); !ok || b != 0xFF {
+		t.Error("parsing mapping: wrong encoded byte")
+	}
+	if _, ok := c.DecodeByte(0x98); ok {
+		t.Error("parsing mapping: undefined byte is defined")
+	}
+	if _, ok := c.DecodeByte(0x43); ok {
+		t.Error("parsing mapping: unlisted byte is defined")
+	}
+	if _, ok := c.DecodeByte(0x99); ok {
+		t.Error("parsing mapping: code point sequence is defined")
+	}
+	if r, ok := c.DecodeByte(0x9A); !ok || r != '%' {
+		t.Error("parsing mapping: code point with direction hint is not defined")
+	}
+
+	if err := Register("test-mapping", c); err != nil {
+		t.Fatal("registering mapping: wrong error value")
+	}
+	test_decode, err := Decode("AB\x80\x98", "test-mapping")
+	if !errors.Is(err, ErrInvalidCodepoint) || test_decode != "ABЂ"+string(utf8.RuneError) {
+		t.Error("decoding from registered mapping: wrong result")
+	}
+
+	for _, bad := range []string{"0x100\t0x0041", "0x41\t0x0041\t0x0042", "41\t0x0041", "0x41\t0x0041+", "0x41\t<LR>+", "0x41\t0x0041\n0x41\t0x0042"} {
+		if _, err := ParseUnicodeMapping(strings.NewReader(bad)); err == nil {
+			t.Errorf("parsing invalid mapping %q: wrong error value", bad)
+		}
+	}
+}
@@ -0,0 +1,1003 @@
+// Code generated by gen_names.go from the comments of the codec-*.go files; DO NOT EDIT.
+
+package charmap
+
+// runeNames holds the names of the characters of the builtin encodings, sorted by rune.
+var runeNames = [...]runeName{
+	{0x0000, "NULL"},
+	{0x0001, "START OF HEADING"},
+	{0x0002, "START OF TEXT"},
+	{0x0003, "END OF TEXT"},
+	{0x0004, "END OF TRANSMISSION"},
+	{0x0005, "ENQUIRY"},
+	{0x0006, "ACKNOWLEDGE"},
+	{0x0007, "BELL"},
+	{0x0008, "BACKSPACE"},
+	{0x0009, "HORIZONTAL TABULATION"},
+	{0x000A, "LINE FEED"},
+	{0x000B, "VERTICAL TABULATION"},
+	{0x000C, "FORM FEED"},
+	{0x000D, "CARRIAGE RETURN"},
+	{0x000E, "SHIFT OUT"},
+	{0x000F, "SHIFT IN"},
+	{0x0010, "DATA LINK ESCAPE"},
+	{0x0011, "DEVICE CONTROL ONE"},
+	{0x0012, "DEVICE CONTROL TWO"},
+	{0x0013, "DEVICE CONTROL THREE"},
+	{0x0014, "DEVICE CONTROL FOUR"},
+	{0x0015, "NEGATIVE ACKNOWLEDGE"},
+	{0x0016, "SYNCHRONOUS IDLE"},
+	{0x0017, "END OF TRANSMISSION BLOCK"},
+	{0x0018, "CANCEL"},
+	{0x0019, "END OF MEDIUM"},
+	{0x001A, "SUBSTITUTE"},
+	{0x001B, "ESCAPE"},
+	{0x001C, "FILE SEPARATOR"},
+	{0x001D, "GROUP SEPARATOR"},
+	{0x001E, "RECORD SEPARATOR"},
+	{0x001F, "UNIT SEPARATOR"},
+	{0x0020, "SPACE"},
+	{0x0021, "EXCLAMATION MARK"},
+	{0x0022, "QUOTATION MARK"},
+	{0x0023, "NUMBER SIGN"},
+	{0x0024, "DOLLAR SIGN"},
+	{0x0025, "PERCENT SIGN"},
+	{0x0026, "AMPERSAND"},
+	{0x0027, "APOSTROPHE"},
+	{0x0028, "LEFT PARENTHESIS"},
+	{0x0029, "RIGHT PARENTHESIS"},
+	{0x002A, "ASTERISK"},
+	{0x002B, "PLUS SIGN"},
+	{0x002C, "COMMA"},
+	{0x002D, "HYPHEN-MINUS"},
+	{0x002E, "FULL STOP"},
+	{0x002F, "SOLIDUS"},
+	{0x0030, "DIGIT ZERO"},
+	{0x0031, "DIGIT ONE"},
+	{0x0032, "DIGIT TWO"},
+	{0x0033, "DIGIT THREE"},
+	{0x0034, "DIGIT FOUR"},
+	{0x0035, "DIGIT FIVE"},
+	{0x0036, "DIGIT SIX"},
+	{0x0037, "DIGIT SEVEN"},
+	{0x0038, "DIGIT EIGHT"},
+	{0x0039, "DIGIT NINE"},
+	{0x003A, "COLON"},
+	{0x003B, "SEMICOLON"},
+	{0x003C, "LESS-THAN SIGN"},
+	{0x003D, "EQUALS SIGN"},
+	{0x003E, "GREATER-THAN SIGN"},
+	{0x003F, "QUESTION MARK"},
+	{0x0040, "COMMERCIAL AT"},
+	{0x0041, "LATIN CAPITAL LETTER A"},
+	{0x0042, "LATIN CAPITAL LETTER B"},
+	{0x0043, "LATIN CAPITAL LETTER C"},
+	{0x0044, "LATIN CAPITAL LETTER D"},
+	{0x0045, "LATIN CAPITAL LETTER E"},
+	{0x0046, "LATIN CAPITAL LETTER F"},
+	{0x0047, "LATIN CAPITAL LETTER G"},
+	{0x0048, "LATIN CAPITAL LETTER H"},
+	{0x0049, "LATIN CAPITAL LETTER I"},
+	{0x004A, "LATIN CAPITAL LETTER J"},
+	{0x004B, "LATIN CAPITAL LETTER K"},
+	{0x004C, "LATIN CAPITAL LETTER L"},
+	{0x004D, "LATIN CAPITAL LETTER M"},
+	{0x004E, "LATIN CAPITAL LETTER N"},
+	{0x004F, "LATIN CAPITAL LETTER O"},
+	{0x0050, "LATIN CAPITAL LETTER P"},
+	{0x0051, "LATIN CAPITAL LETTER Q"},
+	{0x0052, "LATIN CAPITAL LETTER R"},
+	{0x0053, "LATIN CAPITAL LETTER S"},
+	{0x0054, "LATIN CAPITAL LETTER T"},
+	{0x0055, "LATIN CAPITAL LETTER U"},
+	{0x0056, "LATIN CAPITAL LETTER V"},
+	{0x0057, "LATIN CAPITAL LETTER W"},
+	{0x0058, "LATIN CAPITAL LETTER X"},
+	{0x0059, "LATIN CAPITAL LETTER Y"},
+	{0x005A, "LATIN CAPITAL LETTER Z"},
+	{0x005B, "LEFT SQUARE BRACKET"},
+	{0x005C, "REVERSE SOLIDUS"},
+	{0x005D, "RIGHT SQUARE BRACKET"},
+	{0x005E, "CIRCUMFLEX ACCENT"},
+	{0x005F, "LOW LINE"},
+	{0x0060, "GRAVE ACCENT"},
+	{0x0061, "LATIN SMALL LETTER A"},
+	{0x0062, "LATIN SMALL LETTER B"},
+	{0x0063, "LATIN SMALL LETTER C"},
+	{0x0064, "LATIN SMALL LETTER D"},
+	{0x0065, "LATIN SMALL LETTER E"},
+	{0x0066, "LATIN SMALL LETTER F"},
+	{0x0067, "LATIN SMALL LETTER G"},
+	{0x0068, "LATIN SMALL LETTER H"},
+	{0x0069, "LATIN SMALL LETTER I"},
+	{0x006A, "LATIN SMALL LETTER J"},
+	{0x006B, "LATIN SMALL LETTER K"},
+	{0x006C, "LATIN SMALL LETTER L"},
+	{0x006D, "LATIN SMALL LETTER M"},
+	{0x006E, "LATIN SMALL LETTER N"},
+	{0x006F, "LATIN SMALL LETTER O"},
+	{0x0070, "LATIN SMALL LETTER P"},
+	{0x0071, "LATIN SMALL LETTER Q"},
+	{0x0072, "LATIN SMALL LETTER R"},
+	{0x0073, "LATIN SMALL LETTER S"},
+	{0x0074, "LATIN SMALL LETTER T"},
+	{0x0075, "LATIN SMALL LETTER U"},
+	{0x0076, "LATIN SMALL LETTER V"},
+	{0x0077, "LATIN SMALL LETTER W"},
+	{0x0078, "LATIN SMALL LETTER X"},
+	{0x0079, "LATIN SMALL LETTER Y"},
+	{0x007A, "LATIN SMALL LETTER Z"},
+	{0x007B, "LEFT CURLY BRACKET"},
+	{0x007C, "VERTICAL LINE"},
+	{0x007D, "RIGHT CURLY BRACKET"},
+	{0x007E, "TILDE"},
+	{0x007F, "DELETE"},
+	{0x0080, "<control>"},
+	{0x0081, "<control>"},
+	{0x0082, "<control>"},
+	{0x0083, "<control>"},
+	{0x0084, "<control>"},
+	{0x0085, "<control>"},
+	{0x0086, "<control>"},
+	{0x0087, "<control>"},
+	{0x0088, "<control>"},
+	{0x0089, "<control>"},
+	{0x008A, "<control>"},
+	{0x008B, "<control>"},
+	{0x008C, "<control>"},
+	{0x008D, "<control>"},
+	{0x008E, "<control>"},
+	{0x008F, "<control>"},
+	{0x0090, "<control>"},
+	{0x0091, "<control>"},
+	{0x0092, "<control>"},
+	{0x0093, "<control>"},
+	{0x0094, "<control>"},
+	{0x0095, "<control>"},
+	{0x0096, "<control>"},
+	{0x0097, "<control>"},
+	{0x0098, "<control>"},
+	{0x0099, "<control>"},
+	{0x009A, "<control>"},
+	{0x009B, "<control>"},
+	{0x009C, "<control>"},
+	{0x009D, "<control>"},
+	{0x009E, "<control>"},
+	{0x009F, "<control>"},
+	{0x00A0, "NO-BREAK SPACE"},
+	{0x00A1, "INVERTED EXCLAMATION MARK"},
+	{0x00A2, "CENT SIGN"},
+	{0x00A3, "POUND SIGN"},
+	{0x00A4, "CURRENCY SIGN"},
+	{0x00A5, "YEN SIGN"},
+	{0x00A6, "BROKEN BAR"},
+	{0x00A7, "SECTION SIGN"},
+	{0x00A8, "DIAERESIS"},
+	{0x00A9, "COPYRIGHT SIGN"},
+	{0x00AA, "FEMININE ORDINAL INDICATOR"},
+	{0x00AB, "LEFT-POINTING DOUBLE ANGLE QUOTATION MARK"},
+	{0x00AC, "NOT SIGN"},
+	{0x00AD, "SOFT HYPHEN"},
+	{0x00AE, "REGISTERED SIGN"},
+	{0x00AF, "MACRON"},
+	{0x00B0, "DEGREE SIGN"},
+	{0x00B1, "PLUS-MINUS SIGN"},
+	{0x00B2, "SUPERSCRIPT TWO"},
+	{0x00B3, "SUPERSCRIPT THREE"},
+	{0x00B4, "ACUTE ACCENT"},
+	{0x00B5, "MICRO SIGN"},
+	{0x00B6, "PILCROW SIGN"},
+	{0x00B7, "MIDDLE DOT"},
+	{0x00B8, "CEDILLA"},
+	{0x00B9, "SUPERSCRIPT ONE"},
+	{0x00BA, "MASCULINE ORDINAL INDICATOR"},
+	{0x00BB, "RIGHT-POINTING DOUBLE ANGLE QUOTATION MARK"},
+	{0x00BC, "VULGAR FRACTION ONE QUARTER"},
+	{0x00BD, "VULGAR FRACTION ONE HALF"},
+	{0x00BE, "VULGAR FRACTION THREE QUARTERS"},
+	{0x00BF, "INVERTED QUESTION MARK"},
+	{0x00C0, "LATIN CAPITAL LETTER A WITH GRAVE"},
+	{0x00C1, "LATIN CAPITAL LETTER A WITH ACUTE"},
+	{0x00C2, "LATIN CAPITAL LETTER A WITH CIRCUMFLEX"},
+	{0x00C3, "LATIN CAPITAL LETTER A WITH TILDE"},
+	{0x00C4, "LATIN CAPITAL LETTER A WITH DIAERESIS"},
+	{0x00C5, "LATIN CAPITAL LETTER A WITH RING ABOVE"},
+	{0x00C6, "LATIN CAPITAL LETTER AE"},
+	{0x00C7, "LATIN CAPITAL LETTER C WITH CEDILLA"},
+	{0x00C8, "LATIN CAPITAL LETTER E WITH GRAVE"},
+	{0x00C9, "LATIN CAPITAL LETTER E WITH ACUTE"},
+	{0x00CA, "LATIN CAPITAL LETTER E WITH CIRCUMFLEX"},
+	{0x00CB, "LATIN CAPITAL LETTER E WITH DIAERESIS"},
+	{0x00CC, "LATIN CAPITAL LETTER I WITH GRAVE"},
+	{0x00CD, "LATIN CAPITAL LETTER I WITH ACUTE"},
+	{0x00CE, "LATIN CAPITAL LETTER I WITH CIRCUMFLEX"},
+	{0x00CF, "LATIN CAPITAL LETTER I WITH DIAERESIS"},
+	{0x00D0, "LATIN CAPITAL LETTER ETH"},
+	{0x00D1, "LATIN CAPITAL LETTER N WITH TILDE"},
+	{0x00D2, "LATIN CAPITAL LETTER O WITH GRAVE"},
+	{0x00D3, "LATIN CAPITAL LETTER O WITH ACUTE"},
+	{0x00D4, "LATIN CAPITAL LETTER O WITH CIRCUMFLEX"},
+	{0x00D5, "LATIN CAPITAL LETTER O WITH TILDE"},
+	{0x00D6, "LATIN CAPITAL LETTER O WITH DIAERESIS"},
+	{0x00D7, "MULTIPLICATION SIGN"},
+	{0x00D8, "LATIN CAPITAL LETTER O WITH STROKE"},
+	{0x00D9, "LATIN CAPITAL LETTER U WITH GRAVE"},
+	{0x00DA, "LATIN CAPITAL LETTER U WITH ACUTE"},
+	{0x00DB, "LATIN CAPITAL LETTER U WITH CIRCUMFLEX"},
+	{0x00DC, "LATIN CAPITAL LETTER U WITH DIAERESIS"},
+	{0x00DD, "LATIN CAPITAL LETTER Y WITH ACUTE"},
+	{0x00DE, "LATIN CAPITAL LETTER THORN"},
+	{0x00DF, "LATIN SMALL LETTER SHARP S"},
+	{0x00E0, "LATIN SMALL LETTER A WITH GRAVE"},
+	{0x00E1, "LATIN SMALL LETTER A WITH ACUTE"},
+	{0x00E2, "LATIN SMALL LETTER A WITH CIRCUMFLEX"},
+	{0x00E3, "LATIN SMALL LETTER A WITH TILDE"},
+	{0x00E4, "LATIN SMALL LETTER A WITH DIAERESIS"},
+	{0x00E5, "LATIN SMALL LETTER A WITH RING ABOVE"},
+	{0x00E6, "LATIN SMALL LETTER AE"},
+	{0x00E7, "LATIN SMALL LETTER C WITH CEDILLA"},
+	{0x00E8, "LATIN SMALL LETTER E WITH GRAVE"},
+	{0x00E9, "LATIN SMALL LETTER E WITH ACUTE"},
+	{0x00EA, "LATIN SMALL LETTER E WITH CIRCUMFLEX"},
+	{0x00EB, "LATIN SMALL LETTER E WITH DIAERESIS"},
+	{0x00EC, "LATIN SMALL LETTER I WITH GRAVE"},
+	{0x00ED, "LATIN SMALL LETTER I WITH ACUTE"},
+	{0x00EE, "LATIN SMALL LETTER I WITH CIRCUMFLEX"},
+	{0x00EF, "LATIN SMALL LETTER I WITH DIAERESIS"},
+	{0x00F0, "LATIN SMALL LETTER ETH"},
+	{0x00F1, "LATIN SMALL LETTER N WITH TILDE"},
+	{0x00F2, "LATIN SMALL LETTER O WITH GRAVE"},
+	{0x00F3, "LATIN SMALL LETTER O WITH ACUTE"},
+	{0x00F4, "LATIN SMALL LETTER O WITH CIRCUMFLEX"},
+	{0x00F5, "LATIN SMALL LETTER O WITH TILDE"},
+	{0x00F6, "LATIN SMALL LETTER O WITH DIAERESIS"},
+	{0x00F7, "DIVISION SIGN"},
+	{0x00F8, "LATIN SMALL LETTER O WITH STROKE"},
+	{0x00F9, "LATIN SMALL LETTER U WITH GRAVE"},
+	{0x00FA, "LATIN SMALL LETTER U WITH ACUTE"},
+	{0x00FB, "LATIN SMALL LETTER U WITH CIRCUMFLEX"},
+	{0x00FC, "LATIN SMALL LETTER U WITH DIAERESIS"},
+	{0x00FD, "LATIN SMALL LETTER Y WITH ACUTE"},
+	{0x00FE, "LATIN SMALL LETTER THORN"},
+	{0x00FF, "LATIN SMALL LETTER Y WITH DIAERESIS"},
+	{0x0100, "LATIN CAPITAL LETTER A WITH MACRON"},
+	{0x0101, "LATIN SMALL LETTER A WITH MACRON"},
+	{0x0102, "LATIN CAPITAL LETTER A WITH BREVE"},
+	{0x0103, "LATIN SMALL LETTER A WITH BREVE"},
+	{0x0104, "LATIN CAPITAL LETTER A WITH OGONEK"},
+	{0x0105, "LATIN SMALL LETTER A WITH OGONEK"},
+	{0x0106, "LATIN CAPITAL LETTER C WITH ACUTE"},
+	{0x0107, "LATIN SMALL LETTER C WITH ACUTE"},
+	{0x0108, "LATIN CAPITAL LETTER C WITH CIRCUMFLEX"},
+	{0x0109, "LATIN SMALL LETTER C WITH CIRCUMFLEX"},
+	{0x010A, "LATIN CAPITAL LETTER C WITH DOT ABOVE"},
+	{0x010B, "LATIN SMALL LETTER C WITH DOT ABOVE"},
+	{0x010C, "LATIN CAPITAL LETTER C WITH CARON"},
+	{0x010D, "LATIN SMALL LETTER C WITH CARON"},
+	{0x010E, "LATIN CAPITAL LETTER D WITH CARON"},
+	{0x010F, "LATIN SMALL LETTER D WITH CARON"},
+	{0x0110, "LATIN CAPITAL LETTER D WITH STROKE"},
+	{0x0111, "LATIN SMALL LETTER D WITH STROKE"},
+	{0x0112, "LATIN CAPITAL LETTER E WITH MACRON"},
+	{0x0113, "LATIN SMALL LETTER E WITH MACRON"},
+	{0x0116, "LATIN CAPITAL LETTER E WITH DOT ABOVE"},
+	{0x0117, "LATIN SMALL LETTER E WITH DOT ABOVE"},
+	{0x0118, "LATIN CAPITAL LETTER E WITH OGONEK"},
+	{0x0119, "LATIN SMALL LETTER E WITH OGONEK"},
+	{0x011A, "LATIN CAPITAL LETTER E WITH CARON"},
+	{0x011B, "LATIN SMALL LETTER E WITH CARON"},
+	{0x011C, "LATIN CAPITAL LETTER G WITH CIRCUMFLEX"},
+	{0x011D, "LATIN SMALL LETTER G WITH CIRCUMFLEX"},
+	{0x011E, "LATIN CAPITAL LETTER G WITH BREVE"},
+	{0x011F, "LATIN SMALL LETTER G WITH BREVE"},
+	{0x0120, "LATIN CAPITAL LETTER G WITH DOT ABOVE"},
+	{0x0121, "LATIN SMALL LETTER G WITH DOT ABOVE"},
+	{0x0122, "LATIN CAPITAL LETTER G WITH CEDILLA"},
+	{0x0123, "LATIN SMALL LETTER G WITH CEDILLA"},
+	{0x0124, "LATIN CAPITAL LETTER H WITH CIRCUMFLEX"},
+	{0x0125, "LATIN SMALL LETTER H WITH CIRCUMFLEX"},
+	{0x0126, "LATIN CAPITAL LETTER H WITH STROKE"},
+	{0x0127, "LATIN SMALL LETTER H WITH STROKE"},
+	{0x0128, "LATIN CAPITAL LETTER I WITH TILDE"},
+	{0x0129, "LATIN SMALL LETTER I WITH TILDE"},
+	{0x012A, "LATIN CAPITAL LETTER I WITH MACRON"},
+	{0x012B, "LATIN SMALL LETTER I WITH MACRON"},
+	{0x012E, "LATIN CAPITAL LETTER I WITH OGONEK"},
+	{0x012F, "LATIN SMALL LETTER I WITH OGONEK"},
+	{0x0130, "LATIN CAPITAL LETTER I WITH DOT ABOVE"},
+	{0x0131, "LATIN SMALL LETTER DOTLESS I"},
+	{0x0134, "LATIN CAPITAL LETTER J WITH CIRCUMFLEX"},
+	{0x0135, "LATIN SMALL LETTER J WITH CIRCUMFLEX"},
+	{0x0136, "LATIN CAPITAL LETTER K WITH CEDILLA"},
+	{0x0137, "LATIN SMALL LETTER K WITH CEDILLA"},
+	{0x0138, "LATIN SMALL LETTER KRA"},
+	{0x0139, "LATIN CAPITAL LETTER L WITH ACUTE"},
+	{0x013A, "LATIN SMALL LETTER L WITH ACUTE"},
+	{0x013B, "LATIN CAPITAL LETTER L WITH CEDILLA"},
+	{0x013C, "LATIN SMALL LETTER L WITH CEDILLA"},
+	{0x013D, "LATIN CAPITAL LETTER L WITH CARON"},
+	{0x013E, "LATIN SMALL LETTER L WITH CARON"},
+	{0x0141, "LATIN CAPITAL LETTER L WITH STROKE"},
+	{0x0142, "LATIN SMALL LETTER L WITH STROKE"},
+	{0x0143, "LATIN CAPITAL LETTER N WITH ACUTE"},
+	{0x0144, "LATIN SMALL LETTER N WITH ACUTE"},
+	{0x0145, "LATIN CAPITAL LETTER N WITH CEDILLA"},
+	{0x0146, "LATIN SMALL LETTER N WITH CEDILLA"},
+	{0x0147, "LATIN CAPITAL LETTER N WITH CARON"},
+	{0x0148, "LATIN SMALL LETTER N WITH CARON"},
+	{0x014A, "LATIN CAPITAL LETTER ENG"},
+	{0x014B, "LATIN SMALL LETTER ENG"},
+	{0x014C, "LATIN CAPITAL LETTER O WITH MACRON"},
+	{0x014D, "LATIN SMALL LETTER O WITH MACRON"},
+	{0x0150, "LATIN CAPITAL LETTER O WITH DOUBLE ACUTE"},
+	{0x0151, "LATIN SMALL LETTER O WITH DOUBLE ACUTE"},
+	{0x0152, "LATIN CAPITAL LIGATURE OE"},
+	{0x0153, "LATIN SMALL LIGATURE OE"},
+	{0x0154, "LATIN CAPITAL LETTER R WITH ACUTE"},
+	{0x0155, "LATIN SMALL LETTER R WITH ACUTE"},
+	{0x0156, "LATIN CAPITAL LETTER R WITH CEDILLA"},
+	{0x0157, "LATIN SMALL LETTER R WITH CEDILLA"},
+	{0x0158, "LATIN CAPITAL LETTER R WITH CARON"},
+	{0x0159, "LATIN SMALL LETTER R WITH CARON"},
+	{0x015A, "LATIN CAPITAL LETTER S WITH ACUTE"},
+	{0x015B, "LATIN SMALL LETTER S WITH ACUTE"},
+	{0x015C, "LATIN CAPITAL LETTER S WITH CIRCUMFLEX"},
+	{0x015D, "LATIN SMALL LETTER S WITH CIRCUMFLEX"},
+	{0x015E, "LATIN CAPITAL LETTER S WITH CEDILLA"},
+	{0x015F, "LATIN SMALL LETTER S WITH CEDILLA"},
+	{0x0160, "LATIN CAPITAL LETTER S WITH CARON"},
+	{0x0161, "LATIN SMALL LETTER S WITH CARON"},
+	{0x0162, "LATIN CAPITAL LETTER T WITH CEDILLA"},
+	{0x0163, "LATIN SMALL LETTER T WITH CEDILLA"},
+	{0x0164, "LATIN CAPITAL LETTER T WITH CARON"},
+	{0x0165, "LATIN SMALL LETTER T WITH CARON"},
+	{0x0166, "LATIN CAPITAL LETTER T WITH STROKE"},
+	{0x0167, "LATIN SMALL LETTER T WITH STROKE"},
+	{0x0168, "LATIN CAPITAL LETTER U WITH TILDE"},
+	{0x0169, "LATIN SMALL LETTER U WITH TILDE"},
+	{0x016A, "LATIN CAPITAL LETTER U WITH MACRON"},
+	{0x016B, "LATIN SMALL LETTER U WITH MACRON"},
+	{0x016C, "LATIN CAPITAL LETTER U WITH BREVE"},
+	{0x016D, "LATIN SMALL LETTER U WITH BREVE"},
+	{0x016E, "LATIN CAPITAL LETTER U WITH RING ABOVE"},
+	{0x016F, "LATIN SMALL LETTER U WITH RING ABOVE"},
+	{0x0170, "LATIN CAPITAL LETTER U WITH DOUBLE ACUTE"},
+	{0x0171, "LATIN SMALL LETTER U WITH DOUBLE ACUTE"},
+	{0x0172, "LATIN CAPITAL LETTER U WITH OGONEK"},
+	{0x0173, "LATIN SMALL LETTER U WITH OGONEK"},
+	{0x0174, "LATIN CAPITAL LETTER W WITH CIRCUMFLEX"},
+	{0x0175, "LATIN SMALL LETTER W WITH CIRCUMFLEX"},
+	{0x0176, "LATIN CAPITAL LETTER Y WITH CIRCUMFLEX"},
+	{0x0177, "LATIN SMALL LETTER Y WITH CIRCUMFLEX"},
+	{0x0178, "LATIN CAPITAL LETTER Y WITH DIAERESIS"},
+	{0x0179, "LATIN CAPITAL LETTER Z WITH ACUTE"},
+	{0x017A, "LATIN SMALL LETTER Z WITH ACUTE"},
+	{0x017B, "LATIN CAPITAL LETTER Z WITH DOT ABOVE"},
+	{0x017C, "LATIN SMALL LETTER Z WITH DOT ABOVE"},
+	{0x017D, "LATIN CAPITAL LETTER Z WITH CARON"},
+	{0x017E, "LATIN SMALL LETTER Z WITH CARON"},
+	{0x0192, "LATIN SMALL LETTER F WITH HOOK"},
+	{0x01A0, "LATIN CAPITAL LETTER O WITH HORN"},
+	{0x01A1, "LATIN SMALL LETTER O WITH HORN"},
+	{0x01AF, "LATIN CAPITAL LETTER U WITH HORN"},
+	{0x01B0, "LATIN SMALL LETTER U WITH HORN"},
+	{0x0218, "LATIN CAPITAL LETTER S WITH COMMA BELOW"},
+	{0x0219, "LATIN SMALL LETTER S WITH COMMA BELOW"},
+	{0x021A, "LATIN CAPITAL LETTER T WITH COMMA BELOW"},
+	{0x021B, "LATIN SMALL LETTER T WITH COMMA BELOW"},
+	{0x02C6, "MODIFIER LETTER CIRCUMFLEX ACCENT"},
+	{0x02C7, "CARON"},
+	{0x02D8, "BREVE"},
+	{0x02D9, "DOT ABOVE"},
+	{0x02DA, "RING ABOVE"},
+	{0x02DB, "OGONEK"},
+	{0x02DC, "SMALL TILDE"},
+	{0x02DD, "DOUBLE ACUTE ACCENT"},
+	{0x0300, "COMBINING GRAVE ACCENT"},
+	{0x0301, "COMBINING ACUTE ACCENT"},
+	{0x0303, "COMBINING TILDE"},
+	{0x0309, "COMBINING HOOK ABOVE"},
+	{0x0323, "COMBINING DOT BELOW"},
+	{0x037A, "GREEK YPOGEGRAMMENI"},
+	{0x0384, "GREEK TONOS"},
+	{0x0385, "GREEK DIALYTIKA TONOS"},
+	{0x0386, "GREEK CAPITAL LETTER ALPHA WITH TONOS"},
+	{0x0387, "GREEK ANO TELEIA"},
+	{0x0388, "GREEK CAPITAL LETTER EPSILON WITH TONOS"},
+	{0x0389, "GREEK CAPITAL LETTER ETA WITH TONOS"},
+	{0x038A, "GREEK CAPITAL LETTER IOTA WITH TONOS"},
+	{0x038C, "GREEK CAPITAL LETTER OMICRON WITH TONOS"},
+	{0x038E, "GREEK CAPITAL LETTER UPSILON WITH TONOS"},
+	{0x038F, "GREEK CAPITAL LETTER OMEGA WITH TONOS"},
+	{0x0390, "GREEK SMALL LETTER IOTA WITH DIALYTIKA AND TONOS"},
+	{0x0391, "GREEK CAPITAL LETTER ALPHA"},
+	{0x0392, "GREEK CAPITAL LETTER BETA"},
+	{0x0393, "GREEK CAPITAL LETTER GAMMA"},
+	{0x0394, "GREEK CAPITAL LETTER DELTA"},
+	{0x0395, "GREEK CAPITAL LETTER EPSILON"},
+	{0x0396, "GREEK CAPITAL LETTER ZETA"},
+	{0x0397, "GREEK CAPITAL LETTER ETA"},
+	{0x0398, "GREEK CAPITAL LETTER THETA"},
+	{0x0399, "GREEK CAPITAL LETTER IOTA"},
+	{0x039A, "GREEK CAPITAL LETTER KAPPA"},
+	{0x039B, "GREEK CAPITAL LETTER LAMDA"},
+	{0x039C, "GREEK CAPITAL LETTER MU"},
+	{0x039D, "GREEK CAPITAL LETTER NU"},
+	{0x039E, "GREEK CAPITAL LETTER XI"},
+	{0x039F, "GREEK CAPITAL LETTER OMICRON"},
+	{0x03A0, "GREEK CAPITAL LETTER PI"},
+	{0x03A1, "GREEK CAPITAL LETTER RHO"},
+	{0x03A3, "GREEK CAPITAL LETTER SIGMA"},
+	{0x03A4, "GREEK CAPITAL LETTER TAU"},
+	{0x03A5, "GREEK CAPITAL LETTER UPSILON"},
+	{0x03A6, "GREEK CAPITAL LETTER PHI"},
+	{0x03A7, "GREEK CAPITAL LETTER CHI"},
+	{0x03A8, "GREEK CAPITAL LETTER PSI"},
+	{0x03A9, "GREEK CAPITAL LETTER OMEGA"},
+	{0x03AA, "GREEK CAPITAL LETTER IOTA WITH DIALYTIKA"},
+	{0x03AB, "GREEK CAPITAL LETTER UPSILON WITH DIALYTIKA"},
+	{0x03AC, "GREEK SMALL LETTER ALPHA WITH TONOS"},
+	{0x03AD, "GREEK SMALL LETTER EPSILON WITH TONOS"},
+	{0x03AE, "GREEK SMALL LETTER ETA WITH TONOS"},
+	{0x03AF, "GREEK SMALL LETTER IOTA WITH TONOS"},
+	{0x03B0, "GREEK SMALL LETTER UPSILON WITH DIALYTIKA AND TONOS"},
+	{0x03B1, "GREEK SMALL LETTER ALPHA"},
+	{0x03B2, "GREEK SMALL LETTER BETA"},
+	{0x03B3, "GREEK SMALL LETTER GAMMA"},
+	{0x03B4, "GREEK SMALL LETTER DELTA"},
+	{0x03B5, "GREEK SMALL LETTER EPSILON"},
+	{0x03B6, "GREEK SMALL LETTER ZETA"},
+	{0x03B7, "GREEK SMALL LETTER ETA"},
+	{0x03B8, "GREEK SMALL LETTER THETA"},
+	{0x03B9, "GREEK SMALL LETTER IOTA"},
+	{0x03BA, "GREEK SMALL LETTER KAPPA"},
+	{0x03BB, "GREEK SMALL LETTER LAMDA"},
+	{0x03BC, "GREEK SMALL LETTER MU"},
+	{0x03BD, "GREEK SMALL LETTER NU"},
+	{0x03BE, "GREEK SMALL LETTER XI"},
+	{0x03BF, "GREEK SMALL LETTER OMICRON"},
+	{0x03C0, "GREEK SMALL LETTER PI"},
+	{0x03C1, "GREEK SMALL LETTER RHO"},
+	{0x03C2, "GREEK SMALL LETTER FINAL SIGMA"},
+	{0x03C3, "GREEK SMALL LETTER SIGMA"},
+	{0x03C4, "GREEK SMALL LETTER TAU"},
+	{0x03C5, "GREEK SMALL LETTER UPSILON"},
+	{0x03C6, "GREEK SMALL LETTER PHI"},
+	{0x03C7, "GREEK SMALL LETTER CHI"},
+	{0x03C8, "GREEK SMALL LETTER PSI"},
+	{0x03C9, "GREEK SMALL LETTER OMEGA"},
+	{0x03CA, "GREEK SMALL LETTER IOTA WITH DIALYTIKA"},
+	{0x03CB, "GREEK SMALL LETTER UPSILON WITH DIALYTIKA"},
+	{0x03CC, "GREEK SMALL LETTER OMICRON WITH TONOS"},
+	{0x03CD, "GREEK SMALL LETTER UPSILON WITH TONOS"},
+	{0x03CE, "GREEK SMALL LETTER OMEGA WITH TONOS"},
+	{0x0401, "CYRILLIC CAPITAL LETTER IO"},
+	{0x0402, "CYRILLIC CAPITAL LETTER DJE"},
+	{0x0403, "CYRILLIC CAPITAL LETTER GJE"},
+	{0x0404, "CYRILLIC CAPITAL LETTER UKRAINIAN IE"},
+	{0x0405, "CYRILLIC CAPITAL LETTER DZE"},
+	{0x0406, "CYRILLIC CAPITAL LETTER BYELORUSSIAN-UKRAINIAN I"},
+	{0x0407, "CYRILLIC CAPITAL LETTER YI"},
+	{0x0408, "CYRILLIC CAPITAL LETTER JE"},
+	{0x0409, "CYRILLIC CAPITAL LETTER LJE"},
+	{0x040A, "CYRILLIC CAPITAL LETTER NJE"},
+	{0x040B, "CYRILLIC CAPITAL LETTER TSHE"},
+	{0x040C, "CYRILLIC CAPITAL LETTER KJE"},
+	{0x040E, "CYRILLIC CAPITAL LETTER SHORT U"},
+	{0x040F, "CYRILLIC CAPITAL LETTER DZHE"},
+	{0x0410, "CYRILLIC CAPITAL LETTER A"},
+	{0x0411, "CYRILLIC CAPITAL LETTER BE"},
+	{0x0412, "CYRILLIC CAPITAL LETTER VE"},
+	{0x0413, "CYRILLIC CAPITAL LETTER GHE"},
+	{0x0414, "CYRILLIC CAPITAL LETTER DE"},
+	{0x0415, "CYRILLIC CAPITAL LETTER IE"},
+	{0x0416, "CYRILLIC CAPITAL LETTER ZHE"},
+	{0x0417, "CYRILLIC CAPITAL LETTER ZE"},
+	{0x0418, "CYRILLIC CAPITAL LETTER I"},
+	{0x0419, "CYRILLIC CAPITAL LETTER SHORT I"},
+	{0x041A, "CYRILLIC CAPITAL LETTER KA"},
+	{0x041B, "CYRILLIC CAPITAL LETTER EL"},
+	{0x041C, "CYRILLIC CAPITAL LETTER EM"},
+	{0x041D, "CYRILLIC CAPITAL LETTER EN"},
+	{0x041E, "CYRILLIC CAPITAL LETTER O"},
+	{0x041F, "CYRILLIC CAPITAL LETTER PE"},
+	{0x0420, "CYRILLIC CAPITAL LETTER ER"},
+	{0x0421, "CYRILLIC CAPITAL LETTER ES"},
+	{0x0422, "CYRILLIC CAPITAL LETTER TE"},
+	{0x0423, "CYRILLIC CAPITAL LETTER U"},
+	{0x0424, "CYRILLIC CAPITAL LETTER EF"},
+	{0x0425, "CYRILLIC CAPITAL LETTER HA"},
+	{0x0426, "CYRILLIC CAPITAL LETTER TSE"},
+	{0x0427, "CYRILLIC CAPITAL LETTER CHE"},
+	{0x0428, "CYRILLIC CAPITAL LETTER SHA"},
+	{0x0429, "CYRILLIC CAPITAL LETTER SHCHA"},
+	{0x042A, "CYRILLIC CAPITAL LETTER HARD SIGN"},
+	{0x042B, "CYRILLIC CAPITAL LETTER YERU"},
+	{0x042C, "CYRILLIC CAPITAL LETTER SOFT SIGN"},
+	{0x042D, "CYRILLIC CAPITAL LETTER E"},
+	{0x042E, "CYRILLIC CAPITAL LETTER YU"},
+	{0x042F, "CYRILLIC CAPITAL LETTER YA"},
+	{0x0430, "CYRILLIC SMALL LETTER A"},
+	{0x0431, "CYRILLIC SMALL LETTER BE"},
+	{0x0432, "CYRILLIC SMALL LETTER VE"},
+	{0x0433, "CYRILLIC SMALL LETTER GHE"},
+	{0x0434, "CYRILLIC SMALL LETTER DE"},
+	{0x0435, "CYRILLIC SMALL LETTER IE"},
+	{0x0436, "CYRILLIC SMALL LETTER ZHE"},
+	{0x0437, "CYRILLIC SMALL LETTER ZE"},
+	{0x0438, "CYRILLIC SMALL LETTER I"},
+	{0x0439, "CYRILLIC SMALL LETTER SHORT I"},
+	{0x043A, "CYRILLIC SMALL LETTER KA"},
+	{0x043B, "CYRILLIC SMALL LETTER EL"},
+	{0x043C, "CYRILLIC SMALL LETTER EM"},
+	{0x043D, "CYRILLIC SMALL LETTER EN"},
+	{0x043E, "CYRILLIC SMALL LETTER O"},
+	{0x043F, "CYRILLIC SMALL LETTER PE"},
+	{0x0440, "CYRILLIC SMALL LETTER ER"},
+	{0x0441, "CYRILLIC SMALL LETTER ES"},
+	{0x0442, "CYRILLIC SMALL LETTER TE"},
+	{0x0443, "CYRILLIC SMALL LETTER U"},
+	{0x0444, "CYRILLIC SMALL LETTER EF"},
+	{0x0445, "CYRILLIC SMALL LETTER HA"},
+	{0x0446, "CYRILLIC SMALL LETTER TSE"},
+	{0x0447, "CYRILLIC SMALL LETTER CHE"},
+	{0x0448, "CYRILLIC SMALL LETTER SHA"},
+	{0x0449, "CYRILLIC SMALL LETTER SHCHA"},
+	{0x044A, "CYRILLIC SMALL LETTER HARD SIGN"},
+	{0x044B, "CYRILLIC SMALL LETTER YERU"},
+	{0x044C, "CYRILLIC SMALL LETTER SOFT SIGN"},
+	{0x044D, "CYRILLIC SMALL LETTER E"},
+	{0x044E, "CYRILLIC SMALL LETTER YU"},
+	{0x044F, "CYRILLIC SMALL LETTER YA"},
+	{0x0451, "CYRILLIC SMALL LETTER IO"},
+	{0x0452, "CYRILLIC SMALL LETTER DJE"},
+	{0x0453, "CYRILLIC SMALL LETTER GJE"},
+	{0x0454, "CYRILLIC SMALL LETTER UKRAINIAN IE"},
+	{0x0455, "CYRILLIC SMALL LETTER DZE"},
+	{0x0456, "CYRILLIC SMALL LETTER BYELORUSSIAN-UKRAINIAN I"},
+	{0x0457, "CYRILLIC SMALL LETTER YI"},
+	{0x0458, "CYRILLIC SMALL LETTER JE"},
+	{0x0459, "CYRILLIC SMALL LETTER LJE"},
+	{0x045A, "CYRILLIC SMALL LETTER NJE"},
+	{0x045B, "CYRILLIC SMALL LETTER TSHE"},
+	{0x045C, "CYRILLIC SMALL LETTER KJE"},
+	{0x045E, "CYRILLIC SMALL LETTER SHORT U"},
+	{0x045F, "CYRILLIC SMALL LETTER DZHE"},
+	{0x0490, "CYRILLIC CAPITAL LETTER GHE WITH UPTURN"},
+	{0x0491, "CYRILLIC SMALL LETTER GHE WITH UPTURN"},
+	{0x05B0, "HEBREW POINT SHEVA"},
+	{0x05B1, "HEBREW POINT HATAF SEGOL"},
+	{0x05B2, "HEBREW POINT HATAF PATAH"},
+	{0x05B3, "HEBREW POINT HATAF QAMATS"},
+	{0x05B4, "HEBREW POINT HIRIQ"},
+	{0x05B5, "HEBREW POINT TSERE"},
+	{0x05B6, "HEBREW POINT SEGOL"},
+	{0x05B7, "HEBREW POINT PATAH"},
+	{0x05B8, "HEBREW POINT QAMATS"},
+	{0x05B9, "HEBREW POINT HOLAM"},
+	{0x05BB, "HEBREW POINT QUBUTS"},
+	{0x05BC, "HEBREW POINT DAGESH OR MAPIQ"},
+	{0x05BD, "HEBREW POINT METEG"},
+	{0x05BE, "HEBREW PUNCTUATION MAQAF"},
+	{0x05BF, "HEBREW POINT RAFE"},
+	{0x05C0, "HEBREW PUNCTUATION PASEQ"},
+	{0x05C1, "HEBREW POINT SHIN DOT"},
+	{0x05C2, "HEBREW POINT SIN DOT"},
+	{0x05C3, "HEBREW PUNCTUATION SOF PASUQ"},
+	{0x05D0, "HEBREW LETTER ALEF"},
+	{0x05D1, "HEBREW LETTER BET"},
+	{0x05D2, "HEBREW LETTER GIMEL"},
+	{0x05D3, "HEBREW LETTER DALET"},
+	{0x05D4, "HEBREW LETTER HE"},
+	{0x05D5, "HEBREW LETTER VAV"},
+	{0x05D6, "HEBREW LETTER ZAYIN"},
+	{0x05D7, "HEBREW LETTER HET"},
+	{0x05D8, "HEBREW LETTER TET"},
+	{0x05D9, "HEBREW LETTER YOD"},
+	{0x05DA, "HEBREW LETTER FINAL KAF"},
+	{0x05DB, "HEBREW LETTER KAF"},
+	{0x05DC, "HEBREW LETTER LAMED"},
+	{0x05DD, "HEBREW LETTER FINAL MEM"},
+	{0x05DE, "HEBREW LETTER MEM"},
+	{0x05DF, "HEBREW LETTER FINAL NUN"},
+	{0x05E0, "HEBREW LETTER NUN"},
+	{0x05E1, "HEBREW LETTER SAMEKH"},
+	{0x05E2, "HEBREW LETTER AYIN"},
+	{0x05E3, "HEBREW LETTER FINAL PE"},
+	{0x05E4, "HEBREW LETTER PE"},
+	{0x05E5, "HEBREW LETTER FINAL TSADI"},
+	{0x05E6, "HEBREW LETTER TSADI"},
+	{0x05E7, "HEBREW LETTER QOF"},
+	{0x05E8, "HEBREW LETTER RESH"},
+	{0x05E9, "HEBREW LETTER SHIN"},
+	{0x05EA, "HEBREW LETTER TAV"},
+	{0x05F0, "HEBREW LIGATURE YIDDISH DOUBLE VAV"},
+	{0x05F1, "HEBREW LIGATURE YIDDISH VAV YOD"},
+	{0x05F2, "HEBREW LIGATURE YIDDISH DOUBLE YOD"},
+	{0x05F3, "HEBREW PUNCTUATION GERESH"},
+	{0x05F4, "HEBREW PUNCTUATION GERSHAYIM"},
+	{0x060C, "ARABIC COMMA"},
+	{0x061B, "ARABIC SEMICOLON"},
+	{0x061F, "ARABIC QUESTION MARK"},
+	{0x0621, "ARABIC LETTER HAMZA"},
+	{0x0622, "ARABIC LETTER ALEF WITH MADDA ABOVE"},
+	{0x0623, "ARABIC LETTER ALEF WITH HAMZA ABOVE"},
+	{0x0624, "ARABIC LETTER WAW WITH HAMZA ABOVE"},
+	{0x0625, "ARABIC LETTER ALEF WITH HAMZA BELOW"},
+	{0x0626, "ARABIC LETTER YEH WITH HAMZA ABOVE"},
+	{0x0627, "ARABIC LETTER ALEF"},
+	{0x0628, "ARABIC LETTER BEH"},
+	{0x0629, "ARABIC LETTER TEH MARBUTA"},
+	{0x062A, "ARABIC LETTER TEH"},
+	{0x062B, "ARABIC LETTER THEH"},
+	{0x062C, "ARABIC LETTER JEEM"},
+	{0x062D, "ARABIC LETTER HAH"},
+	{0x062E, "ARABIC LETTER KHAH"},
+	{0x062F, "ARABIC LETTER DAL"},
+	{0x0630, "ARABIC LETTER THAL"},
+	{0x0631, "ARABIC LETTER REH"},
+	{0x0632, "ARABIC LETTER ZAIN"},
+	{0x0633, "ARABIC LETTER SEEN"},
+	{0x0634, "ARABIC LETTER SHEEN"},
+	{0x0635, "ARABIC LETTER SAD"},
+	{0x0636, "ARABIC LETTER DAD"},
+	{0x0637, "ARABIC LETTER TAH"},
+	{0x0638, "ARABIC LETTER ZAH"},
+	{0x0639, "ARABIC LETTER AIN"},
+	{0x063A, "ARABIC LETTER GHAIN"},
+	{0x0640, "ARABIC TATWEEL"},
+	{0x0641, "ARABIC LETTER FEH"},
+	{0x0642, "ARABIC LETTER QAF"},
+	{0x0643, "ARABIC LETTER KAF"},
+	{0x0644, "ARABIC LETTER LAM"},
+	{0x0645, "ARABIC LETTER MEEM"},
+	{0x0646, "ARABIC LETTER NOON"},
+	{0x0647, "ARABIC LETTER HEH"},
+	{0x0648, "ARABIC LETTER WAW"},
+	{0x0649, "ARABIC LETTER ALEF MAKSURA"},
+	{0x064A, "ARABIC LETTER YEH"},
+	{0x064B, "ARABIC FATHATAN"},
+	{0x064C, "ARABIC DAMMATAN"},
+	{0x064D, "ARABIC KASRATAN"},
+	{0x064E, "ARABIC FATHA"},
+	{0x064F, "ARABIC DAMMA"},
+	{0x0650, "ARABIC KASRA"},
+	{0x0651, "ARABIC SHADDA"},
+	{0x0652, "ARABIC SUKUN"},
+	{0x0660, "ARABIC-INDIC DIGIT ZERO"},
+	{0x0661, "ARABIC-INDIC DIGIT ONE"},
+	{0x0662, "ARABIC-INDIC DIGIT TWO"},
+	{0x0663, "ARABIC-INDIC DIGIT THREE"},
+	{0x0664, "ARABIC-INDIC DIGIT FOUR"},
+	{0x0665, "ARABIC-INDIC DIGIT FIVE"},
+	{0x0666, "ARABIC-INDIC DIGIT SIX"},
+	{0x0667, "ARABIC-INDIC DIGIT SEVEN"},
+	{0x0668, "ARABIC-INDIC DIGIT EIGHT"},
+	{0x0669, "ARABIC-INDIC DIGIT NINE"},
+	{0x066A, "ARABIC PERCENT SIGN"},
+	{0x0679, "ARABIC LETTER TTEH"},
+	{0x067E, "ARABIC LETTER PEH"},
+	{0x0686, "ARABIC LETTER TCHEH"},
+	{0x0688, "ARABIC LETTER DDAL"},
+	{0x0691, "ARABIC LETTER RREH"},
+	{0x0698, "ARABIC LETTER JEH"},
+	{0x06A9, "ARABIC LETTER KEHEH"},
+	{0x06AF, "ARABIC LETTER GAF"},
+	{0x06BA, "ARABIC LETTER NOON GHUNNA"},
+	{0x06BE, "ARABIC LETTER HEH DOACHASHMEE"},
+	{0x06C1, "ARABIC LETTER HEH GOAL"},
+	{0x06D2, "ARABIC LETTER YEH BARREE"},
+	{0x06F0, "EXTENDED ARABIC-INDIC DIGIT ZERO"},
+	{0x06F1, "EXTENDED ARABIC-INDIC DIGIT ONE"},
+	{0x06F2, "EXTENDED ARABIC-INDIC DIGIT TWO"},
+	{0x06F3, "EXTENDED ARABIC-INDIC DIGIT THREE"},
+	{0x06F4, "EXTENDED ARABIC-INDIC DIGIT FOUR"},
+	{0x06F5, "EXTENDED ARABIC-INDIC DIGIT FIVE"},
+	{0x06F6, "EXTENDED ARABIC-INDIC DIGIT SIX"},
+	{0x06F7, "EXTENDED ARABIC-INDIC DIGIT SEVEN"},
+	{0x06F8, "EXTENDED ARABIC-INDIC DIGIT EIGHT"},
+	{0x06F9, "EXTENDED ARABIC-INDIC DIGIT NINE"},
+	{0x0E01, "THAI CHARACTER KO KAI"},
+	{0x0E02, "THAI CHARACTER KHO KHAI"},
+	{0x0E03, "THAI CHARACTER KHO KHUAT"},
+	{0x0E04, "THAI CHARACTER KHO KHWAI"},
+	{0x0E05, "THAI CHARACTER KHO KHON"},
+	{0x0E06, "THAI CHARACTER KHO RAKHANG"},
+	{0x0E07, "THAI CHARACTER NGO NGU"},
+	{0x0E08, "THAI CHARACTER CHO CHAN"},
+	{0x0E09, "THAI CHARACTER CHO CHING"},
+	{0x0E0A, "THAI CHARACTER CHO CHANG"},
+	{0x0E0B, "THAI CHARACTER SO SO"},
+	{0x0E0C, "THAI CHARACTER CHO CHOE"},
+	{0x0E0D, "THAI CHARACTER YO YING"},
+	{0x0E0E, "THAI CHARACTER DO CHADA"},
+	{0x0E0F, "THAI CHARACTER TO PATAK"},
+	{0x0E10, "THAI CHARACTER THO THAN"},
+	{0x0E11, "THAI CHARACTER THO NANGMONTHO"},
+	{0x0E12, "THAI CHARACTER THO PHUTHAO"},
+	{0x0E13, "THAI CHARACTER NO NEN"},
+	{0x0E14, "THAI CHARACTER DO DEK"},
+	{0x0E15, "THAI CHARACTER TO TAO"},
+	{0x0E16, "THAI CHARACTER THO THUNG"},
+	{0x0E17, "THAI CHARACTER THO THAHAN"},
+	{0x0E18, "THAI CHARACTER THO THONG"},
+	{0x0E19, "THAI CHARACTER NO NU"},
+	{0x0E1A, "THAI CHARACTER BO BAIMAI"},
+	{0x0E1B, "THAI CHARACTER PO PLA"},
+	{0x0E1C, "THAI CHARACTER PHO PHUNG"},
+	{0x0E1D, "THAI CHARACTER FO FA"},
+	{0x0E1E, "THAI CHARACTER PHO PHAN"},
+	{0x0E1F, "THAI CHARACTER FO FAN"},
+	{0x0E20, "THAI CHARACTER PHO SAMPHAO"},
+	{0x0E21, "THAI CHARACTER MO MA"},
+	{0x0E22, "THAI CHARACTER YO YAK"},
+	{0x0E23, "THAI CHARACTER RO RUA"},
+	{0x0E24, "THAI CHARACTER RU"},
+	{0x0E25, "THAI CHARACTER LO LING"},
+	{0x0E26, "THAI CHARACTER LU"},
+	{0x0E27, "THAI CHARACTER WO WAEN"},
+	{0x0E28, "THAI CHARACTER SO SALA"},
+	{0x0E29, "THAI CHARACTER SO RUSI"},
+	{0x0E2A, "THAI CHARACTER SO SUA"},
+	{0x0E2B, "THAI CHARACTER HO HIP"},
+	{0x0E2C, "THAI CHARACTER LO CHULA"},
+	{0x0E2D, "THAI CHARACTER O ANG"},
+	{0x0E2E, "THAI CHARACTER HO NOKHUK"},
+	{0x0E2F, "THAI CHARACTER PAIYANNOI"},
+	{0x0E30, "THAI CHARACTER SARA A"},
+	{0x0E31, "THAI CHARACTER MAI HAN-AKAT"},
+	{0x0E32, "THAI CHARACTER SARA AA"},
+	{0x0E33, "THAI CHARACTER SARA AM"},
+	{0x0E34, "THAI CHARACTER SARA I"},
+	{0x0E35, "THAI CHARACTER SARA II"},
+	{0x0E36, "THAI CHARACTER SARA UE"},
+	{0x0E37, "THAI CHARACTER SARA UEE"},
+	{0x0E38, "THAI CHARACTER SARA U"},
+	{0x0E39, "THAI CHARACTER SARA UU"},
+	{0x0E3A, "THAI CHARACTER PHINTHU"},
+	{0x0E3F, "THAI CURRENCY SYMBOL BAHT"},
+	{0x0E40, "THAI CHARACTER SARA E"},
+	{0x0E41, "THAI CHARACTER SARA AE"},
+	{0x0E42, "THAI CHARACTER SARA O"},
+	{0x0E43, "THAI CHARACTER SARA AI MAIMUAN"},
+	{0x0E44, "THAI CHARACTER SARA AI MAIMALAI"},
+	{0x0E45, "THAI CHARACTER LAKKHANGYAO"},
+	{0x0E46, "THAI CHARACTER MAIYAMOK"},
+	{0x0E47, "THAI CHARACTER MAITAIKHU"},
+	{0x0E48, "THAI CHARACTER MAI EK"},
+	{0x0E49, "THAI CHARACTER MAI THO"},
+	{0x0E4A, "THAI CHARACTER MAI TRI"},
+	{0x0E4B, "THAI CHARACTER MAI CHATTAWA"},
+	{0x0E4C, "THAI CHARACTER THANTHAKHAT"},
+	{0x0E4D, "THAI CHARACTER NIKHAHIT"},
+	{0x0E4E, "THAI CHARACTER YAMAKKAN"},
+	{0x0E4F, "THAI CHARACTER FONGMAN"},
+	{0x0E50, "THAI DIGIT ZERO"},
+	{0x0E51, "THAI DIGIT ONE"},
+	{0x0E52, "THAI DIGIT TWO"},
+	{0x0E53, "THAI DIGIT THREE"},
+	{0x0E54, "THAI DIGIT FOUR"},
+	{0x0E55, "THAI DIGIT FIVE"},
+	{0x0E56, "THAI DIGIT SIX"},
+	{0x0E57, "THAI DIGIT SEVEN"},
+	{0x0E58, "THAI DIGIT EIGHT"},
+	{0x0E59, "THAI DIGIT NINE"},
+	{0x0E5A, "THAI CHARACTER ANGKHANKHU"},
+	{0x0E5B, "THAI CHARACTER KHOMUT"},
+	{0x1E02, "LATIN CAPITAL LETTER B WITH DOT ABOVE"},
+	{0x1E03, "LATIN SMALL LETTER B WITH DOT ABOVE"},
+	{0x1E0A, "LATIN CAPITAL LETTER D WITH DOT ABOVE"},
+	{0x1E0B, "LATIN SMALL LETTER D WITH DOT ABOVE"},
+	{0x1E1E, "LATIN CAPITAL LETTER F WITH DOT ABOVE"},
+	{0x1E1F, "LATIN SMALL LETTER F WITH DOT ABOVE"},
+	{0x1E40, "LATIN CAPITAL LETTER M WITH DOT ABOVE"},
+	{0x1E41, "LATIN SMALL LETTER M WITH DOT ABOVE"},
+	{0x1E56, "LATIN CAPITAL LETTER P WITH DOT ABOVE"},
+	{0x1E57, "LATIN SMALL LETTER P WITH DOT ABOVE"},
+	{0x1E60, "LATIN CAPITAL LETTER S WITH DOT ABOVE"},
+	{0x1E61, "LATIN SMALL LETTER S WITH DOT ABOVE"},
+	{0x1E6A, "LATIN CAPITAL LETTER T WITH DOT ABOVE"},
+	{0x1E6B, "LATIN SMALL LETTER T WITH DOT ABOVE"},
+	{0x1E80, "LATIN CAPITAL LETTER W WITH GRAVE"},
+	{0x1E81, "LATIN SMALL LETTER W WITH GRAVE"},
+	{0x1E82, "LATIN CAPITAL LETTER W WITH ACUTE"},
+	{0x1E83, "LATIN SMALL LETTER W WITH ACUTE"},
+	{0x1E84, "LATIN CAPITAL LETTER W WITH DIAERESIS"},
+	{0x1E85, "LATIN SMALL LETTER W WITH DIAERESIS"},
+	{0x1EF2, "LATIN CAPITAL LETTER Y WITH GRAVE"},
+	{0x1EF3, "LATIN SMALL LETTER Y WITH GRAVE"},
+	{0x200C, "ZERO WIDTH NON-JOINER"},
+	{0x200D, "ZERO WIDTH JOINER"},
+	{0x200E, "LEFT-TO-RIGHT MARK"},
+	{0x200F, "RIGHT-TO-LEFT MARK"},
+	{0x2013, "EN DASH"},
+	{0x2014, "EM DASH"},
+	{0x2015, "HORIZONTAL BAR"},
+	{0x2017, "DOUBLE LOW LINE"},
+	{0x2018, "LEFT SINGLE QUOTATION MARK"},
+	{0x2019, "RIGHT SINGLE QUOTATION MARK"},
+	{0x201A, "SINGLE LOW-9 QUOTATION MARK"},
+	{0x201C, "LEFT DOUBLE QUOTATION MARK"},
+	{0x201D, "RIGHT DOUBLE QUOTATION MARK"},
+	{0x201E, "DOUBLE LOW-9 QUOTATION MARK"},
+	{0x2020, "DAGGER"},
+	{0x2021, "DOUBLE DAGGER"},
+	{0x2022, "BULLET"},
+	{0x2026, "HORIZONTAL ELLIPSIS"},
+	{0x2030, "PER MILLE SIGN"},
+	{0x2039, "SINGLE LEFT-POINTING ANGLE QUOTATION MARK"},
+	{0x203A, "SINGLE RIGHT-POINTING ANGLE QUOTATION MARK"},
+	{0x2044, "FRACTION SLASH"},
+	{0x207F, "SUPERSCRIPT LATIN SMALL LETTER N"},
+	{0x20A7, "PESETA SIGN"},
+	{0x20AA, "NEW SHEQEL SIGN"},
+	{0x20AB, "DONG SIGN"},
+	{0x20AC, "EURO SIGN"},
+	{0x20AF, "DRACHMA SIGN"},
+	{0x2116, "NUMERO SIGN"},
+	{0x2122, "TRADE MARK SIGN"},
+	{0x2126, "OHM SIGN"},
+	{0x2202, "PARTIAL DIFFERENTIAL"},
+	{0x2206, "INCREMENT"},
+	{0x220F, "N-ARY PRODUCT"},
+	{0x2211, "N-ARY SUMMATION"},
+	{0x2219, "BULLET OPERATOR"},
+	{0x221A, "SQUARE ROOT"},
+	{0x221E, "INFINITY"},
+	{0x2229, "INTERSECTION"},
+	{0x222B, "INTEGRAL"},
+	{0x2248, "ALMOST EQUAL TO"},
+	{0x2260, "NOT EQUAL TO"},
+	{0x2261, "IDENTICAL TO"},
+	{0x2264, "LESS-THAN OR EQUAL TO"},
+	{0x2265, "GREATER-THAN OR EQUAL TO"},
+	{0x2310, "REVERSED NOT SIGN"},
+	{0x2320, "TOP HALF INTEGRAL"},
+	{0x2321, "BOTTOM HALF INTEGRAL"},
+	{0x2500, "BOX DRAWINGS LIGHT HORIZONTAL"},
+	{0x2502, "BOX DRAWINGS LIGHT VERTICAL"},
+	{0x250C, "BOX DRAWINGS LIGHT DOWN AND RIGHT"},
+	{0x2510, "BOX DRAWINGS LIGHT DOWN AND LEFT"},
+	{0x2514, "BOX DRAWINGS LIGHT UP AND RIGHT"},
+	{0x2518, "BOX DRAWINGS LIGHT UP AND LEFT"},
+	{0x251C, "BOX DRAWINGS LIGHT VERTICAL AND RIGHT"},
+	{0x2524, "BOX DRAWINGS LIGHT VERTICAL AND LEFT"},
+	{0x252C, "BOX DRAWINGS LIGHT DOWN AND HORIZONTAL"},
+	{0x2534, "BOX DRAWINGS LIGHT UP AND HORIZONTAL"},
+	{0x253C, "BOX DRAWINGS LIGHT VERTICAL AND HORIZONTAL"},
+	{0x2550, "BOX DRAWINGS DOUBLE HORIZONTAL"},
+	{0x2551, "BOX DRAWINGS DOUBLE VERTICAL"},
+	{0x2552, "BOX DRAWINGS DOWN SINGLE AND RIGHT DOUBLE"},
+	{0x2553, "BOX DRAWINGS DOWN DOUBLE AND RIGHT SINGLE"},
+	{0x2554, "BOX DRAWINGS DOUBLE DOWN AND RIGHT"},
+	{0x2555, "BOX DRAWINGS DOWN SINGLE AND LEFT DOUBLE"},
+	{0x2556, "BOX DRAWINGS DOWN DOUBLE AND LEFT SINGLE"},
+	{0x2557, "BOX DRAWINGS DOUBLE DOWN AND LEFT"},
+	{0x2558, "BOX DRAWINGS UP SINGLE AND RIGHT DOUBLE"},
+	{0x2559, "BOX DRAWINGS UP DOUBLE AND RIGHT SINGLE"},
+	{0x255A, "BOX DRAWINGS DOUBLE UP AND RIGHT"},
+	{0x255B, "BOX DRAWINGS UP SINGLE AND LEFT DOUBLE"},
+	{0x255C, "BOX DRAWINGS UP DOUBLE AND LEFT SINGLE"},
+	{0x255D, "BOX DRAWINGS DOUBLE UP AND LEFT"},
+	{0x255E, "BOX DRAWINGS VERTICAL SINGLE AND RIGHT DOUBLE"},
+	{0x255F, "BOX DRAWINGS VERTICAL DOUBLE AND RIGHT SINGLE"},
+	{0x2560, "BOX DRAWINGS DOUBLE VERTICAL AND RIGHT"},
+	{0x2561, "BOX DRAWINGS VERTICAL SINGLE AND LEFT DOUBLE"},
+	{0x2562, "BOX DRAWINGS VERTICAL DOUBLE AND LEFT SINGLE"},
+	{0x2563, "BOX DRAWINGS DOUBLE VERTICAL AND LEFT"},
+	{0x2564, "BOX DRAWINGS DOWN SINGLE AND HORIZONTAL DOUBLE"},
+	{0x2565, "BOX DRAWINGS DOWN DOUBLE AND HORIZONTAL SINGLE"},
+	{0x2566, "BOX DRAWINGS DOUBLE DOWN AND HORIZONTAL"},
+	{0x2567, "BOX DRAWINGS UP SINGLE AND HORIZONTAL DOUBLE"},
+	{0x2568, "BOX DRAWINGS UP DOUBLE AND HORIZONTAL SINGLE"},
+	{0x2569, "BOX DRAWINGS DOUBLE UP AND HORIZONTAL"},
+	{0x256A, "BOX DRAWINGS VERTICAL SINGLE AND HORIZONTAL DOUBLE"},
+	{0x256B, "BOX DRAWINGS VERTICAL DOUBLE AND HORIZONTAL SINGLE"},
+	{0x256C, "BOX DRAWINGS DOUBLE VERTICAL AND HORIZONTAL"},
+	{0x2580, "UPPER HALF BLOCK"},
+	{0x2584, "LOWER HALF BLOCK"},
+	{0x2588, "FULL BLOCK"},
+	{0x258C, "LEFT HALF BLOCK"},
+	{0x2590, "RIGHT HALF BLOCK"},
+	{0x2591, "LIGHT SHADE"},
+	{0x2592, "MEDIUM SHADE"},
+	{0x2593, "DARK SHADE"},
+	{0x25A0, "BLACK SQUARE"},
+	{0x25CA, "LOZENGE"},
+	{0xFB01, "LATIN SMALL LIGATURE FI"},
+	{0xFB02, "LATIN SMALL LIGATURE FL"},
+	{0xFB56, "ARABIC LETTER PEH ISOLATED FORM"},
+	{0xFB58, "ARABIC LETTER PEH INITIAL FORM"},
+	{0xFB66, "ARABIC LETTER TTEH ISOLATED FORM"},
+	{0xFB68, "ARABIC LETTER TTEH INITIAL FORM"},
+	{0xFB7A, "ARABIC LETTER TCHEH ISOLATED FORM"},
+	{0xFB7C, "ARABIC LETTER TCHEH INITIAL FORM"},
+	{0xFB84, "ARABIC LETTER DAHAL ISOLATED FORM"},
+	{0xFB8A, "ARABIC LETTER JEH ISOLATED FORM"},
+	{0xFB8C, "ARABIC LETTER RREH ISOLATED FORM"},
+	{0xFB92, "ARABIC LETTER GAF ISOLATED FORM"},
+	{0xFB94, "ARABIC LETTER GAF INITIAL FORM"},
+	{0xFB9E, "ARABIC LETTER NOON GHUNNA ISOLATED FORM"},
+	{0xFBA6, "ARABIC LETTER HEH GOAL ISOLATED FORM"},
+	{0xFBA8, "ARABIC LETTER HEH GOAL INITIAL FORM"},
+	{0xFBA9, "ARABIC LETTER HEH GOAL MEDIAL FORM"},
+	{0xFBAA, "ARABIC LETTER HEH DOACHASHMEE ISOLATED FORM"},
+	{0xFBAE, "ARABIC LETTER YEH BARREE ISOLATED FORM"},
+	{0xFBB0, "ARABIC LETTER YEH BARREE WITH HAMZA ABOVE ISOLATED FORM"},
+	{0xFE7C, "ARABIC SHADDA ISOLATED FORM"},
+	{0xFE7D, "ARABIC SHADDA MEDIAL FORM"},
+	{0xFE80, "ARABIC LETTER HAMZA ISOLATED FORM"},
+	{0xFE81, "ARABIC LETTER ALEF WITH MADDA ABOVE ISOLATED FORM"},
+	{0xFE82, "ARABIC LETTER ALEF WITH MADDA ABOVE FINAL FORM"},
+	{0xFE83, "ARABIC LETTER ALEF WITH HAMZA ABOVE ISOLATED FORM"},
+	{0xFE84, "ARABIC LETTER ALEF WITH HAMZA ABOVE FINAL FORM"},
+	{0xFE85, "ARABIC LETTER WAW WITH HAMZA ABOVE ISOLATED FORM"},
+	{0xFE89, "ARABIC LETTER YEH WITH HAMZA ABOVE ISOLATED FORM"},
+	{0xFE8A, "ARABIC LETTER YEH WITH HAMZA ABOVE FINAL FORM"},
+	{0xFE8B, "ARABIC LETTER YEH WITH HAMZA ABOVE INITIAL FORM"},
+	{0xFE8D, "ARABIC LETTER ALEF ISOLATED FORM"},
+	{0xFE8E, "ARABIC LETTER ALEF FINAL FORM"},
+	{0xFE8F, "ARABIC LETTER BEH ISOLATED FORM"},
+	{0xFE91, "ARABIC LETTER BEH INITIAL FORM"},
+	{0xFE93, "ARABIC LETTER TEH MARBUTA ISOLATED FORM"},
+	{0xFE95, "ARABIC LETTER TEH ISOLATED FORM"},
+	{0xFE97, "ARABIC LETTER TEH INITIAL FORM"},
+	{0xFE99, "ARABIC LETTER THEH ISOLATED FORM"},
+	{0xFE9B, "ARABIC LETTER THEH INITIAL FORM"},
+	{0xFE9D, "ARABIC LETTER JEEM ISOLATED FORM"},
+	{0xFE9F, "ARABIC LETTER JEEM INITIAL FORM"},
+	{0xFEA1, "ARABIC LETTER HAH ISOLATED FORM"},
+	{0xFEA3, "ARABIC LETTER HAH INITIAL FORM"},
+	{0xFEA5, "ARABIC LETTER KHAH ISOLATED FORM"},
+	{0xFEA7, "ARABIC LETTER KHAH INITIAL FORM"},
+	{0xFEA9, "ARABIC LETTER DAL ISOLATED FORM"},
+	{0xFEAB, "ARABIC LETTER THAL ISOLATED FORM"},
+	{0xFEAD, "ARABIC LETTER REH ISOLATED FORM"},
+	{0xFEAF, "ARABIC LETTER ZAIN ISOLATED FORM"},
+	{0xFEB1, "ARABIC LETTER SEEN ISOLATED FORM"},
+	{0xFEB3, "ARABIC LETTER SEEN INITIAL FORM"},
+	{0xFEB5, "ARABIC LETTER SHEEN ISOLATED FORM"},
+	{0xFEB7, "ARABIC LETTER SHEEN INITIAL FORM"},
+	{0xFEB9, "ARABIC LETTER SAD ISOLATED FORM"},
+	{0xFEBB, "ARABIC LETTER SAD INITIAL FORM"},
+	{0xFEBD, "ARABIC LETTER DAD ISOLATED FORM"},
+	{0xFEBF, "ARABIC LETTER DAD INITIAL FORM"},
+	{0xFEC1, "ARABIC LETTER TAH ISOLATED FORM"},
+	{0xFEC5, "ARABIC LETTER ZAH ISOLATED FORM"},
+	{0xFEC9, "ARABIC LETTER AIN ISOLATED FORM"},
+	{0xFECA, "ARABIC LETTER AIN FINAL FORM"},
+	{0xFECB, "ARABIC LETTER AIN INITIAL FORM"},
+	{0xFECC, "ARABIC LETTER AIN MEDIAL FORM"},
+	{0xFECD, "ARABIC LETTER GHAIN ISOLATED FORM"},
+	{0xFECE, "ARABIC LETTER GHAIN FINAL FORM"},
+	{0xFECF, "ARABIC LETTER GHAIN INITIAL FORM"},
+	{0xFED0, "ARABIC LETTER GHAIN MEDIAL FORM"},
+	{0xFED1, "ARABIC LETTER FEH ISOLATED FORM"},
+	{0xFED3, "ARABIC LETTER FEH INITIAL FORM"},
+	{0xFED5, "ARABIC LETTER QAF ISOLATED FORM"},
+	{0xFED7, "ARABIC LETTER QAF INITIAL FORM"},
+	{0xFED9, "ARABIC LETTER KAF ISOLATED FORM"},
+	{0xFEDB, "ARABIC LETTER KAF INITIAL FORM"},
+	{0xFEDD, "ARABIC LETTER LAM ISOLATED FORM"},
+	{0xFEDF, "ARABIC LETTER LAM INITIAL FORM"},
+	{0xFEE0, "ARABIC LETTER LAM MEDIAL FORM"},
+	{0xFEE1, "ARABIC LETTER MEEM ISOLATED FORM"},
+	{0xFEE3, "ARABIC LETTER MEEM INITIAL FORM"},
+	{0xFEE5, "ARABIC LETTER NOON ISOLATED FORM"},
+	{0xFEE7, "ARABIC LETTER NOON INITIAL FORM"},
+	{0xFEE9, "ARABIC LETTER HEH ISOLATED FORM"},
+	{0xFEEB, "ARABIC LETTER HEH INITIAL FORM"},
+	{0xFEEC, "ARABIC LETTER HEH MEDIAL FORM"},
+	{0xFEED, "ARABIC LETTER WAW ISOLATED FORM"},
+	{0xFEEF, "ARABIC LETTER ALEF MAKSURA ISOLATED FORM"},
+	{0xFEF0, "ARABIC LETTER ALEF MAKSURA FINAL FORM"},
+	{0xFEF1, "ARABIC LETTER YEH ISOLATED FORM"},
+	{0xFEF2, "ARABIC LETTER YEH FINAL FORM"},
+	{0xFEF3, "ARABIC LETTER YEH INITIAL FORM"},
+	{0xFEF5, "ARABIC LIGATURE LAM WITH ALEF WITH MADDA ABOVE ISOLATED FORM"},
+	{0xFEF6, "ARABIC LIGATURE LAM WITH ALEF WITH MADDA ABOVE FINAL FORM"},
+	{0xFEF7, "ARABIC LIGATURE LAM WITH ALEF WITH HAMZA ABOVE ISOLATED FORM"},
+	{0xFEF8, "ARABIC LIGATURE LAM WITH ALEF WITH HAMZA ABOVE FINAL FORM"},
+	{0xFEFB, "ARABIC LIGATURE LAM WITH ALEF ISOLATED FORM"},
+	{0xFEFC, "ARABIC LIGATURE LAM WITH ALEF FINAL FORM"},
+}
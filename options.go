@@ -0,0 +1,197 @@
+package charmap
+
+import (
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+)
+
+// Option configures how the conversion functions handle characters which cannot be converted.
+// By default such characters are replaced with '?' when encoding and with utf8.RuneError when decoding.
+// If several options set the handling for the same direction, the last one is used.
+// Unless Strict is used, the conversion goes on and the returned *ConversionError
+// reports the characters which were handled.
+type Option func(*options)
+
+type options struct {
+	// encodeErr appends the replacement for a rune which cannot be encoded,
+	// decodeErr the replacement for an undefined byte.
+	// If they are nil, the conversion stops at the first failure.
+	encodeErr func(dst []byte, c Codec, r rune) []byte
+	decodeErr func(dst []byte, b byte) []byte
+
+	surrogateEscape bool
+}
+
+var defaultOptions = options{
+	encodeErr: func(dst []byte, c Codec, r rune) []byte {
+		return append(dst, '?')
+	},
+	decodeErr: func(dst []byte, b byte) []byte {
+		return utf8.AppendRune(dst, utf8.RuneError)
+	},
+}
+
+func newOptions(opts []Option) *options {
+	if len(opts) == 0 {
+		return &defaultOptions
+	}
+
+	o := defaultOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return &o
+}
+
+// Strict stops the conversion at the first character which cannot be converted.
+// The output converted so far is returned along with the *ConversionError.
+func Strict() Option {
+	return func(o *options) {
+		o.encodeErr = nil
+		o.decodeErr = nil
+	}
+}
+
+// ReplaceByte replaces runes which cannot be encoded with the byte b.
+func ReplaceByte(b byte) Option {
+	return func(o *options) {
+		o.encodeErr = func(dst []byte, c Codec, r rune) []byte {
+			return append(dst, b)
+		}
+	}
+}
+
+// ReplaceRune replaces undefined bytes with the rune r when decoding.
+func ReplaceRune(r rune) Option {
+	return func(o *options) {
+		o.decodeErr = func(dst []byte, b byte) []byte {
+			return utf8.AppendRune(dst, r)
+		}
+	}
+}
+
+// Ignore drops characters which cannot be converted.
+func Ignore() Option {
+	return func(o *options) {
+		o.encodeErr = func(dst []byte, c Codec, r rune) []byte {
+			return dst
+		}
+		o.decodeErr = func(dst []byte, b byte) []byte {
+			return dst
+		}
+	}
+}
+
+// XMLCharRefReplace replaces runes which cannot be encoded with XML numeric
+// character references such as "&#1103;". It applies to encoding only.
+func XMLCharRefReplace() Option {
+	return func(o *options) {
+		o.encodeErr = func(dst []byte, c Codec, r rune) []byte {
+			return appendASCII(dst, c, "&#"+strconv.Itoa(int(r))+";")
+		}
+	}
+}
+
+// BackslashReplace replaces runes which cannot be encoded with backslash escapes
+// such as "\u044f", and undefined bytes with escapes such as "\x98" when decoding.
+func BackslashReplace() Option {
+	return func(o *options) {
+		o.encodeErr = func(dst []byte, c Codec, r rune) []byte {
+			var esc string
+			switch {
+			case r <= 0xFF:
+				esc = fmt.Sprintf(`\x%02x`, r)
+			case r <= 0xFFFF:
+				esc = fmt.Sprintf(`\u%04x`, r)
+			default:
+				esc = fmt.Sprintf(`\U%08x`, r)
+			}
+			return appendASCII(dst, c, esc)
+		}
+		o.decodeErr = func(dst []byte, b byte) []byte {
+			return append(dst, fmt.Sprintf(`\x%02x`, b)...)
+		}
+	}
+}
+
+// EncodeFallback calls f for every rune which cannot be encoded and writes
+// the returned bytes to the output as they are. It applies to encoding only.
+func EncodeFallback(f func(r rune) []byte) Option {
+	return func(o *options) {
+		o.encodeErr = func(dst []byte, c Codec, r rune) []byte {
+			return append(dst, f(r)...)
+		}
+	}
+}
+
+// SurrogateEscapeBase is the first code point of the Private Use Area block
+// used by SurrogateEscape: byte b is represented by SurrogateEscapeBase+b.
+const SurrogateEscapeBase rune = 0xF700
+
+// SurrogateEscape makes the conversion lossless. When decoding, every byte which would not
+// survive a round trip (undefined bytes and bytes sharing a rune with another byte)
+// is mapped to the code point SurrogateEscapeBase+b; when encoding, these code points
+// are mapped back to the original bytes. As a result Encode(Decode(data)) == data
+// for every encoding if the option is used in both directions.
+// Escaped bytes are not reported as errors.
+func SurrogateEscape() Option {
+	return func(o *options) {
+		o.surrogateEscape = true
+	}
+}
+
+// codec returns the codec to use for the conversion.
+func (o *options) codec(c Codec) Codec {
+	if o.surrogateEscape {
+		return escapeCodec{c}
+	}
+	return c
+}
+
+// escapeCodec implements SurrogateEscape on top of another codec.
+type escapeCodec struct {
+	c Codec
+}
+
+// escaped reports whether b does not survive a round trip through c.
+func (e escapeCodec) escaped(b byte) bool {
+	r, ok := e.c.DecodeByte(b)
+	if !ok {
+		return true
+	}
+	b2, ok := e.c.EncodeRune(r)
+	return !ok || b2 != b
+}
+
+func (e escapeCodec) EncodeRune(r rune) (byte, bool) {
+	if b, ok := e.c.EncodeRune(r); ok {
+		return b, true
+	}
+	if r >= SurrogateEscapeBase && r <= SurrogateEscapeBase+0xFF {
+		b := byte(r - SurrogateEscapeBase)
+		if e.escaped(b) {
+			return b, true
+		}
+	}
+	return 0, false
+}
+
+func (e escapeCodec) DecodeByte(b byte) (rune, bool) {
+	if e.escaped(b) {
+		return SurrogateEscapeBase + rune(b), true
+	}
+	return e.c.DecodeByte(b)
+}
+
+// appendASCII appends the ASCII string s encoded with c to dst.
+func appendASCII(dst []byte, c Codec, s string) []byte {
+	for i := 0; i < len(s); i++ {
+		b, ok := c.EncodeRune(rune(s[i]))
+		if !ok {
+			b = '?'
+		}
+		dst = append(dst, b)
+	}
+	return dst
+}
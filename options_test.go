@@ -0,0 +1,141 @@
+package charmap
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestEncodeOptions(t *testing.T) {
+	requireEncodings(t, "cp1251")
+
+	data := "Да, αβ!"
+
+	tests := []struct {
+		name   string
+		opts   []Option
+		result string
+		count  int
+	}{
+		{"default", nil, "\xC4\xE0, ??!", 2},
+		{"strict", []Option{Strict()}, "\xC4\xE0, ", 1},
+		{"replace byte", []Option{ReplaceByte('_')}, "\xC4\xE0, __!", 2},
+		{"ignore", []Option{Ignore()}, "\xC4\xE0, !", 2},
+		{"xml", []Option{XMLCharRefReplace()}, "\xC4\xE0, &#945;&#946;!", 2},
+		{"backslash", []Option{BackslashReplace()}, "\xC4\xE0, \\u03b1\\u03b2!", 2},
+		{"fallback", []Option{EncodeFallback(func(r rune) []byte { return []byte{'<', byte(r - 'α' + 'a'), '>'} })}, "\xC4\xE0, <a><b>!", 2},
+		{"last wins", []Option{Ignore(), ReplaceByte('*')}, "\xC4\xE0, **!", 2},
+	}
+
+	for _, test := range tests {
+		result, err := Encode(data, "cp1251", test.opts...)
+		if result != test.result {
+			t.Errorf("encoding with %s option: wrong result %q", test.name, result)
+		}
+		var cerr *ConversionError
+		if !errors.As(err, &cerr) || cerr.Count != test.count || cerr.Offset != 6 {
+			t.Errorf("encoding with %s option: wrong error value", test.name)
+		}
+	}
+
+	if result, err := Encode("Да", "cp1251", Strict()); err != nil || result != "\xC4\xE0" {
+		t.Error("encoding legal string with strict option: wrong result")
+	}
+}
+
+func TestDecodeOptions(t *testing.T) {
+	requireEncodings(t, "cp1251")
+
+	data := []byte("A\x98B\x98")
+
+	tests := []struct {
+		name   string
+		opts   []Option
+		result string
+		count  int
+	}{
+		{"default", nil, "A�B�", 2},
+		{"strict", []Option{Strict()}, "A", 1},
+		{"replace rune", []Option{ReplaceRune('?')}, "A?B?", 2},
+		{"ignore", []Option{Ignore()}, "AB", 2},
+		{"backslash", []Option{BackslashReplace()}, "A\\x98B\\x98", 2},
+		{"encoding only", []Option{XMLCharRefReplace()}, "A�B�", 2},
+	}
+
+	for _, test := range tests {
+		result, err := DecodeBytes(data, "cp1251", test.opts...)
+		if string(result) != test.result {
+			t.Errorf("decoding with %s option: wrong result %q", test.name, result)
+		}
+		var cerr *ConversionError
+		if !errors.As(err, &cerr) || cerr.Count != test.count || cerr.Offset != 1 {
+			t.Errorf("decoding with %s option: wrong error value", test.name)
+		}
+	}
+}
+
+func TestStreamOptions(t *testing.T) {
+	requireEncodings(t, "cp1251")
+
+	r, _ := NewDecodingReader(strings.NewReader("A\x98B"), "cp1251", Strict())
+	result, err := ioutil.ReadAll(r)
+	if !errors.Is(err, ErrInvalidCodepoint) || string(result) != "A" {
+		t.Error("decoding reader with strict option: wrong result")
+	}
+
+	var buf bytes.Buffer
+	w, _ := NewEncodingWriter(&buf, "cp1251", Strict())
+	if n, err := w.Write([]byte("Да")); n != 4 || err != nil {
+		t.Error("encoding writer with strict option: wrong return values")
+	}
+	if n, err := w.Write([]byte(", αβ!")); n != 2 || !errors.Is(err, ErrInvalidCodepoint) {
+		t.Error("encoding writer with strict option: wrong return values for illegal codepoint")
+	}
+	if buf.String() != "\xC4\xE0, " {
+		t.Error("encoding writer with strict option: wrong result")
+	}
+
+	buf.Reset()
+	w, _ = NewEncodingWriter(&buf, "cp1251", XMLCharRefReplace())
+	w.Write([]byte("α\xce"))
+	w.Write([]byte("\xb2"))
+	if err := w.Close(); !errors.Is(err, ErrInvalidCodepoint) || buf.String() != "&#945;&#946;" {
+		t.Error("encoding writer with xml option: wrong result")
+	}
+}
+
+func TestSurrogateEscape(t *testing.T) {
+	requireEncodings(t, "cp1251")
+
+	var all [256]byte
+	for i := range all {
+		all[i] = byte(i)
+	}
+
+	for _, name := range List() {
+		decoded, err := DecodeBytes(all[:], name, SurrogateEscape())
+		if err != nil {
+			t.Errorf("decoding all bytes from %s with surrogate escape: wrong error value", name)
+		}
+		encoded, err := EncodeBytes(decoded, name, SurrogateEscape())
+		if err != nil {
+			t.Errorf("encoding all bytes to %s with surrogate escape: wrong error value", name)
+		}
+		if !bytes.Equal(encoded, all[:]) {
+			t.Errorf("round trip of all bytes through %s with surrogate escape: wrong result", name)
+		}
+	}
+
+	test_decode, err := Decode("A\x98Z", "cp1251", SurrogateEscape())
+	if err != nil || test_decode != "A\uF798Z" {
+		t.Error("decoding undefined byte with surrogate escape: wrong result")
+	}
+
+	// escape code points of defined bytes are not encodable
+	test_encode, err := Encode("A\uF741Z", "cp1251", SurrogateEscape())
+	if !errors.Is(err, ErrInvalidCodepoint) || test_encode != "A?Z" {
+		t.Error("encoding escape code point of a defined byte: wrong result")
+	}
+}
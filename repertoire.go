@@ -0,0 +1,153 @@
+package charmap
+
+import (
+	"sort"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Repertoire returns the set of runes which can be encoded to the specified encoding.
+// The result can be used with unicode.Is, e.g. unicode.Is(rt, 'я').
+// If the specified encoding is unknown, it will return ErrUnknownEncoding
+func Repertoire(encoding string) (*unicode.RangeTable, error) {
+	e, err := Lookup(encoding)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.Repertoire(), nil
+}
+
+// Repertoire returns the set of runes which can be encoded to the encoding.
+func (e *Encoding) Repertoire() *unicode.RangeTable {
+	return rangeTable(encodableRunes(e.codec))
+}
+
+// encodableRunes returns the sorted list of runes which c can encode.
+func encodableRunes(c Codec) []rune {
+	var runes []rune
+
+	if m, ok := c.(*codecMap8Bit); ok {
+		// the encode tables include the encode-only mappings
+		for hi, page := range m.index {
+			if page == 0 {
+				continue
+			}
+			for lo, v := range m.pages[page] {
+				if v != 0 {
+					runes = append(runes, rune(hi<<8|lo))
+				}
+			}
+		}
+		for r := range m.astral {
+			runes = append(runes, r)
+		}
+	} else {
+		seen := make(map[rune]bool)
+		for i := 0; i < 256; i++ {
+			r, ok := c.DecodeByte(byte(i))
+			if !ok || seen[r] {
+				continue
+			}
+			if _, ok := c.EncodeRune(r); ok {
+				seen[r] = true
+				runes = append(runes, r)
+			}
+		}
+	}
+
+	sort.Slice(runes, func(i, j int) bool { return runes[i] < runes[j] })
+	return runes
+}
+
+// rangeTable builds a table from a sorted list of runes.
+func rangeTable(runes []rune) *unicode.RangeTable {
+	rt := &unicode.RangeTable{}
+
+	for i := 0; i < len(runes); {
+		j := i + 1
+		for j < len(runes) && runes[j] == runes[j-1]+1 {
+			j++
+		}
+		lo, hi := runes[i], runes[j-1]
+
+		if hi <= 0xFFFF {
+			rt.R16 = append(rt.R16, unicode.Range16{Lo: uint16(lo), Hi: uint16(hi), Stride: 1})
+			if hi <= unicode.MaxLatin1 {
+				rt.LatinOffset++
+			}
+		} else {
+			rt.R32 = append(rt.R32, unicode.Range32{Lo: uint32(lo), Hi: uint32(hi), Stride: 1})
+		}
+		i = j
+	}
+
+	return rt
+}
+
+// DifferenceKind tells what a Difference describes.
+type DifferenceKind int
+
+const (
+	ByteDiffers DifferenceKind = iota // Byte decodes to different runes in the encodings
+	OnlyInA                           // Rune can be encoded to the first encoding only
+	OnlyInB                           // Rune can be encoded to the second encoding only
+)
+
+// Difference is a difference between two encodings a and b found by Compare.
+type Difference struct {
+	Kind DifferenceKind
+	// For ByteDiffers, the byte and the runes it decodes to in a and b
+	// (utf8.RuneError if it is undefined). For OnlyInA and OnlyInB, the byte
+	// which Rune is encoded to in the encoding which has it.
+	Byte byte
+	A, B rune
+	Rune rune
+}
+
+// Compare returns the differences between the encodings a and b: the bytes whose mappings
+// differ, sorted by byte, followed by the runes which only a can encode and the runes
+// which only b can encode, sorted by rune. It returns nil if the encodings are identical.
+// If one of the encodings is unknown, it will return ErrUnknownEncoding
+func Compare(a, b string) ([]Difference, error) {
+	ea, err := Lookup(a)
+	if err != nil {
+		return nil, err
+	}
+	eb, err := Lookup(b)
+	if err != nil {
+		return nil, err
+	}
+
+	var diffs []Difference
+
+	for i := 0; i < 256; i++ {
+		ra, okA := ea.codec.DecodeByte(byte(i))
+		rb, okB := eb.codec.DecodeByte(byte(i))
+		if !okA {
+			ra = utf8.RuneError
+		}
+		if !okB {
+			rb = utf8.RuneError
+		}
+		if ra != rb {
+			diffs = append(diffs, Difference{Kind: ByteDiffers, Byte: byte(i), A: ra, B: rb})
+		}
+	}
+
+	diffs = appendOnlyIn(diffs, OnlyInA, ea.codec, eb.codec)
+	diffs = appendOnlyIn(diffs, OnlyInB, eb.codec, ea.codec)
+	return diffs, nil
+}
+
+// appendOnlyIn appends the runes which c can encode and other cannot.
+func appendOnlyIn(diffs []Difference, kind DifferenceKind, c, other Codec) []Difference {
+	for _, r := range encodableRunes(c) {
+		if _, ok := other.EncodeRune(r); ok {
+			continue
+		}
+		b, _ := c.EncodeRune(r)
+		diffs = append(diffs, Difference{Kind: kind, Byte: b, Rune: r})
+	}
+	return diffs
+}
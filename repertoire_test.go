@@ -0,0 +1,104 @@
+package charmap
+
+import (
+	"testing"
+	"unicode"
+	"unicode/utf8"
+)
+
+func TestRepertoire(t *testing.T) {
+	requireEncodings(t, "cp1251")
+
+	rt, err := Repertoire("cp1251")
+	if err != nil {
+		t.Fatal("repertoire of cp1251: wrong error value")
+	}
+	for _, r := range "AzЁёЯя€№" {
+		if !unicode.Is(rt, r) {
+			t.Errorf("repertoire of cp1251: %q is missing", r)
+		}
+	}
+	for _, r := range "αß\u0098" {
+		if unicode.Is(rt, r) {
+			t.Errorf("repertoire of cp1251: %q is present", r)
+		}
+	}
+
+	// every rune which can be encoded is in the repertoire, including encode-only ones
+	for _, name := range List() {
+		e, _ := Lookup(name)
+		rt := e.Repertoire()
+		for r := rune(0); r <= 0xFFFF; r++ {
+			_, ok := e.Codec().EncodeRune(r)
+			if ok != unicode.Is(rt, r) {
+				t.Errorf("repertoire of %s: wrong result for %U", name, r)
+				break
+			}
+		}
+	}
+	if compiledIn("mac-cyrillic") {
+		rt, _ = Repertoire("mac-cyrillic")
+		if !unicode.Is(rt, '€') {
+			t.Error("repertoire of mac-cyrillic: encode-only rune is missing")
+		}
+	}
+
+	if _, err := Repertoire("wrong-encoding"); err != ErrUnknownEncoding {
+		t.Error("repertoire of wrong-encoding: wrong error value")
+	}
+}
+
+func TestCompare(t *testing.T) {
+	requireEncodings(t, "cp1252", "iso-8859-1", "iso-8859-5", "cp1251")
+
+	diffs, err := Compare("cp1252", "iso-8859-1")
+	if err != nil {
+		t.Fatal("comparing cp1252 and iso-8859-1: wrong error value")
+	}
+
+	var bytes, onlyA, onlyB int
+	for _, d := range diffs {
+		switch d.Kind {
+		case ByteDiffers:
+			bytes++
+			if d.Byte < 0x80 || d.Byte > 0x9F {
+				t.Errorf("comparing cp1252 and iso-8859-1: unexpected byte difference %+v", d)
+			}
+		case OnlyInA:
+			onlyA++
+		case OnlyInB:
+			onlyB++
+		}
+	}
+	// CP1252 leaves 5 of the 32 bytes undefined
+	if bytes != 32 || onlyA != 27 || onlyB != 32 {
+		t.Errorf("comparing cp1252 and iso-8859-1: %d bytes, %d runes only in cp1252, %d only in iso-8859-1", bytes, onlyA, onlyB)
+	}
+	if diffs[0] != (Difference{Kind: ByteDiffers, Byte: 0x80, A: '€', B: '\u0080'}) {
+		t.Errorf("comparing cp1252 and iso-8859-1: wrong first difference %+v", diffs[0])
+	}
+	if diffs[1] != (Difference{Kind: ByteDiffers, Byte: 0x81, A: utf8.RuneError, B: '\u0081'}) {
+		t.Errorf("comparing cp1252 and iso-8859-1: wrong undefined byte difference %+v", diffs[1])
+	}
+
+	diffs, _ = Compare("iso-8859-5", "cp1251")
+	found := false
+	for _, d := range diffs {
+		if d.Kind == ByteDiffers && d.Byte == 0xA1 && d.A == 'Ё' && d.B == 'Ў' {
+			found = true
+		}
+		if d.Kind == OnlyInB && d.Rune == '€' && d.Byte != 0x88 {
+			t.Errorf("comparing iso-8859-5 and cp1251: wrong byte of euro sign %+v", d)
+		}
+	}
+	if !found {
+		t.Error("comparing iso-8859-5 and cp1251: difference of 0xA1 is missing")
+	}
+
+	if diffs, err := Compare("cp1251", "windows-1251"); diffs != nil || err != nil {
+		t.Error("comparing identical encodings: wrong result")
+	}
+	if _, err := Compare("cp1251", "wrong-encoding"); err != ErrUnknownEncoding {
+		t.Error("comparing with wrong-encoding: wrong error value")
+	}
+}
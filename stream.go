@@ -0,0 +1,281 @@
+package charmap
+
+import (
+	"io"
+	"unicode/utf8"
+)
+
+const streamBufSize = 4096
+
+// DecodingReader converts a stream from an 8bit encoding to UTF-8.
+type DecodingReader struct {
+	r    io.Reader
+	enc  *Encoding
+	opts *options
+	buf  []byte
+	dec  []byte
+	out  []byte
+	err  error
+	pos  int64
+	cerr *ConversionError
+}
+
+// NewDecodingReader returns a reader that decodes data read from r from the specified encoding to UTF-8.
+// Illegal characters are handled as in Decode; when the end of r is reached,
+// a *ConversionError is returned instead of io.EOF if any were found.
+// With the Strict option, the error is returned as soon as the first illegal character is read.
+// If the specified encoding is unknown, it will return ErrUnknownEncoding
+func NewDecodingReader(r io.Reader, encoding string, opts ...Option) (*DecodingReader, error) {
+	e, err := Lookup(encoding)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.NewDecodingReader(r, opts...), nil
+}
+
+// NewDecodingReader returns a reader that decodes data read from r from the encoding to UTF-8,
+// see the NewDecodingReader function.
+func (e *Encoding) NewDecodingReader(r io.Reader, opts ...Option) *DecodingReader {
+	return &DecodingReader{r: r, enc: e, opts: newOptions(opts)}
+}
+
+// fill reads the next chunk of r and decodes it into d.out.
+func (d *DecodingReader) fill() {
+	if d.buf == nil {
+		d.buf = make([]byte, streamBufSize)
+	}
+
+	n, err := d.r.Read(d.buf)
+	if n > 0 {
+		var cerr *ConversionError
+		d.dec, cerr = mapBytesToRunes(d.enc.codec, d.dec[:0], d.buf[:n], d.opts)
+		d.cerr = d.cerr.add(cerr, d.pos, d.pos)
+		d.pos += int64(n)
+		d.out = d.dec
+
+		if cerr != nil && d.opts.decodeErr == nil {
+			d.err = d.enc.conversionError(d.cerr)
+			return
+		}
+	}
+
+	if err != nil {
+		if err == io.EOF && d.cerr != nil {
+			err = d.enc.conversionError(d.cerr)
+		}
+		d.err = err
+	}
+}
+
+// Read implements the io.Reader interface.
+func (d *DecodingReader) Read(p []byte) (n int, err error) {
+	for len(d.out) == 0 {
+		if d.err != nil {
+			return 0, d.err
+		}
+		d.fill()
+	}
+
+	n = copy(p, d.out)
+	d.out = d.out[n:]
+	return n, nil
+}
+
+// WriteTo implements the io.WriterTo interface.
+func (d *DecodingReader) WriteTo(w io.Writer) (n int64, err error) {
+	for {
+		if len(d.out) > 0 {
+			m, err := w.Write(d.out)
+			n += int64(m)
+			d.out = d.out[m:]
+			if err != nil {
+				return n, err
+			}
+		}
+
+		if d.err != nil {
+			if d.err == io.EOF {
+				return n, nil
+			}
+			return n, d.err
+		}
+		d.fill()
+	}
+}
+
+// EncodingWriter converts a stream from UTF-8 to an 8bit encoding.
+// UTF-8 sequences split across writes are handled correctly.
+type EncodingWriter struct {
+	w       io.Writer
+	enc     *Encoding
+	opts    *options
+	partial [utf8.UTFMax]byte
+	np      int
+	buf     []byte
+	out     []byte
+	err     error
+	pos     int64
+	runes   int64
+	cerr    *ConversionError
+}
+
+// NewEncodingWriter returns a writer that encodes data from UTF-8 to the specified encoding and writes it to w.
+// Illegal characters are handled as in Encode; Close reports a *ConversionError if any were found.
+// With the Strict option, Write returns the error as soon as the first illegal character is written.
+// If the specified encoding is unknown, it will return ErrUnknownEncoding
+func NewEncodingWriter(w io.Writer, encoding string, opts ...Option) (*EncodingWriter, error) {
+	e, err := Lookup(encoding)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.NewEncodingWriter(w, opts...), nil
+}
+
+// NewEncodingWriter returns a writer that encodes data from UTF-8 to the encoding and writes it to w,
+// see the NewEncodingWriter function.
+func (e *Encoding) NewEncodingWriter(w io.Writer, opts ...Option) *EncodingWriter {
+	return &EncodingWriter{w: w, enc: e, opts: newOptions(opts)}
+}
+
+// encode encodes the next part of the stream into e.out.
+func (e *EncodingWriter) encode(src []byte) {
+	var cerr *ConversionError
+
+	n := len(e.out)
+	e.out, cerr = mapRunesToBytes(e.enc.codec, e.out, src, e.opts)
+	e.cerr = e.cerr.add(cerr, e.pos, e.runes)
+	e.pos += int64(len(src))
+	e.runes += int64(len(e.out) - n)
+
+	if cerr != nil && e.opts.encodeErr == nil {
+		e.err = e.enc.conversionError(e.cerr)
+	}
+}
+
+// completePartial encodes the runes starting in the buffered incomplete sequence,
+// taking the bytes they need from p. It returns the number of bytes taken from p.
+func (e *EncodingWriter) completePartial(p []byte) int {
+	var buf [2 * utf8.UTFMax]byte
+
+	np := copy(buf[:], e.partial[:e.np])
+	m := np + copy(buf[np:], p)
+
+	i := 0
+	for i < np && e.err == nil {
+		if !utf8.FullRune(buf[i:m]) {
+			// all of p fits into the still incomplete sequence
+			e.np = copy(e.partial[:], buf[i:m])
+			return len(p)
+		}
+		_, size := utf8.DecodeRune(buf[i:m])
+		e.encode(buf[i : i+size])
+		i += size
+	}
+
+	e.np = 0
+	if i < np {
+		return 0
+	}
+	return i - np
+}
+
+// incompleteTail returns the index of the incomplete UTF-8 sequence p ends with, or len(p).
+func incompleteTail(p []byte) int {
+	for i := len(p) - 1; i >= 0 && i > len(p)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(p[i]) {
+			if !utf8.FullRune(p[i:]) {
+				return i
+			}
+			break
+		}
+	}
+	return len(p)
+}
+
+// Write implements the io.Writer interface.
+// A trailing incomplete UTF-8 sequence is buffered until the next call to Write or Close.
+// Once an error occurs, all further writes return it.
+func (e *EncodingWriter) Write(p []byte) (n int, err error) {
+	if e.err != nil {
+		return 0, e.err
+	}
+
+	// stream offset of p[0]
+	start := e.pos + int64(e.np)
+
+	e.out = e.out[:0]
+
+	taken := 0
+	if e.np > 0 {
+		taken = e.completePartial(p)
+	}
+
+	src := p[taken:]
+	tail := incompleteTail(src)
+	if e.err == nil {
+		e.encode(src[:tail])
+	}
+
+	if len(e.out) > 0 {
+		if _, err := e.w.Write(e.out); err != nil {
+			e.err = err
+			return 0, err
+		}
+	}
+
+	if e.err != nil {
+		// stopped by Strict at the first illegal character
+		if n := e.cerr.Offset - start; n > 0 {
+			return int(n), e.err
+		}
+		return 0, e.err
+	}
+
+	e.np += copy(e.partial[e.np:], src[tail:])
+	return len(p), nil
+}
+
+// ReadFrom implements the io.ReaderFrom interface.
+func (e *EncodingWriter) ReadFrom(r io.Reader) (n int64, err error) {
+	if e.buf == nil {
+		e.buf = make([]byte, streamBufSize)
+	}
+
+	for {
+		m, rerr := r.Read(e.buf)
+		n += int64(m)
+		if m > 0 {
+			if _, err := e.Write(e.buf[:m]); err != nil {
+				return n, err
+			}
+		}
+		if rerr == io.EOF {
+			return n, nil
+		}
+		if rerr != nil {
+			return n, rerr
+		}
+	}
+}
+
+// Close flushes a buffered incomplete UTF-8 sequence, replacing it with a substitute character.
+// It returns a *ConversionError if any character written could not be encoded.
+// Close does not close the underlying writer.
+func (e *EncodingWriter) Close() error {
+	if e.err != nil {
+		return e.err
+	}
+
+	if e.np > 0 {
+		e.out = e.out[:0]
+		e.encode(e.partial[:e.np])
+		e.np = 0
+		if _, err := e.w.Write(e.out); err != nil {
+			return err
+		}
+	}
+
+	return e.enc.conversionError(e.cerr)
+}
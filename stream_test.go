@@ -0,0 +1,126 @@
+package charmap
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"unicode/utf8"
+)
+
+func TestDecodingReader(t *testing.T) {
+	requireEncodings(t, "koi8-r", "cp1251")
+
+	pana_koi8r := "\xF7\x20\xDE\xC1\xDD\xC1\xC8\x20\xC0\xC7\xC1\x20\xD6\xC9\xCC\x20\xC2\xD9\x20\xC3\xC9\xD4\xD2\xD5\xD3\x3F"
+	pana_utf8 := "В чащах юга жил бы цитрус?"
+
+	r, err := NewDecodingReader(iotest.OneByteReader(strings.NewReader(pana_koi8r)), "koi8-r")
+	if err != nil {
+		t.Fatal("decoding reader for koi8-r: wrong error value")
+	}
+	test_read, err := ioutil.ReadAll(iotest.HalfReader(r))
+	if err != nil {
+		t.Error("decoding reader for koi8-r: wrong read error value")
+	}
+	if string(test_read) != pana_utf8 {
+		t.Error("decoding reader for koi8-r: wrong result")
+	}
+
+	r, _ = NewDecodingReader(strings.NewReader(pana_koi8r), "koi8-r")
+	var buf bytes.Buffer
+	n, err := io.Copy(&buf, r)
+	if err != nil || n != int64(len(pana_utf8)) {
+		t.Error("decoding reader WriteTo: wrong return values")
+	}
+	if buf.String() != pana_utf8 {
+		t.Error("decoding reader WriteTo: wrong result")
+	}
+
+	r, _ = NewDecodingReader(strings.NewReader("A\x98Z"), "cp1251")
+	test_illegal, err := ioutil.ReadAll(r)
+	if !errors.Is(err, ErrInvalidCodepoint) {
+		t.Error("decoding reader illegal codepoint: wrong error value")
+	}
+	if string(test_illegal) != "A"+string(utf8.RuneError)+"Z" {
+		t.Error("decoding reader illegal codepoint: wrong result")
+	}
+
+	_, err = NewDecodingReader(strings.NewReader(pana_koi8r), "wrong-encoding")
+	if err != ErrUnknownEncoding {
+		t.Error("decoding reader for wrong-encoding: wrong error value")
+	}
+}
+
+func TestEncodingWriter(t *testing.T) {
+	requireEncodings(t, "koi8-r", "cp1251")
+
+	pana_koi8r := "\xF7\x20\xDE\xC1\xDD\xC1\xC8\x20\xC0\xC7\xC1\x20\xD6\xC9\xCC\x20\xC2\xD9\x20\xC3\xC9\xD4\xD2\xD5\xD3\x3F"
+	pana_utf8 := "В чащах юга жил бы цитрус?"
+
+	var buf bytes.Buffer
+	w, err := NewEncodingWriter(&buf, "koi8-r")
+	if err != nil {
+		t.Fatal("encoding writer for koi8-r: wrong error value")
+	}
+	// every multibyte character is split between writes
+	for i := 0; i < len(pana_utf8); i++ {
+		if n, err := w.Write([]byte{pana_utf8[i]}); n != 1 || err != nil {
+			t.Fatal("encoding writer for koi8-r: wrong write return values")
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Error("encoding writer for koi8-r: wrong close error value")
+	}
+	if buf.String() != pana_koi8r {
+		t.Error("encoding writer for koi8-r: wrong result")
+	}
+
+	buf.Reset()
+	w, _ = NewEncodingWriter(&buf, "koi8-r")
+	n, err := io.Copy(w, iotest.OneByteReader(strings.NewReader(pana_utf8)))
+	if err != nil || n != int64(len(pana_utf8)) {
+		t.Error("encoding writer ReadFrom: wrong return values")
+	}
+	if err := w.Close(); err != nil {
+		t.Error("encoding writer ReadFrom: wrong close error value")
+	}
+	if buf.String() != pana_koi8r {
+		t.Error("encoding writer ReadFrom: wrong result")
+	}
+
+	buf.Reset()
+	w, _ = NewEncodingWriter(&buf, "cp1251")
+	w.Write([]byte("AαZ\xD0"))
+	if err := w.Close(); !errors.Is(err, ErrInvalidCodepoint) {
+		t.Error("encoding writer illegal codepoint: wrong error value")
+	}
+	if buf.String() != "A?Z?" {
+		t.Error("encoding writer illegal codepoint: wrong result")
+	}
+
+	_, err = NewEncodingWriter(&buf, "wrong-encoding")
+	if err != ErrUnknownEncoding {
+		t.Error("encoding writer for wrong-encoding: wrong error value")
+	}
+}
+
+func TestStreamConversionError(t *testing.T) {
+	requireEncodings(t, "cp1251")
+
+	r, _ := NewDecodingReader(iotest.OneByteReader(strings.NewReader("AB\x98C\x98")), "cp1251")
+	_, err := ioutil.ReadAll(r)
+	if cerr, ok := err.(*ConversionError); !ok || cerr.Offset != 2 || cerr.Count != 2 {
+		t.Error("decoding reader illegal codepoints: wrong error value")
+	}
+
+	var buf bytes.Buffer
+	w, _ := NewEncodingWriter(&buf, "cp1251")
+	io.Copy(w, iotest.OneByteReader(strings.NewReader("Да, αβ!")))
+	err = w.Close()
+	if cerr, ok := err.(*ConversionError); !ok || cerr.Offset != 6 || cerr.RuneOffset != 4 || cerr.Count != 2 {
+		t.Error("encoding writer illegal codepoints: wrong error value")
+	}
+}
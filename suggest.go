@@ -0,0 +1,95 @@
+package charmap
+
+import (
+	"sort"
+)
+
+// Suggestion is the result of SuggestEncodings for one encoding.
+type Suggestion struct {
+	Encoding    string
+	Encodable   int    // number of runes of the text which can be encoded
+	Unencodable []rune // distinct runes which cannot be encoded, in order of appearance
+}
+
+// familyRank returns the order in which SuggestEncodings prefers the families of encodings
+// which represent the same part of the text. Encodings added with Register come last.
+func familyRank(e *Encoding) int {
+	if e.builtin == nil {
+		return 5
+	}
+	switch e.builtin.family {
+	case FamilyWindows:
+		return 0
+	case FamilyISO:
+		return 1
+	case FamilyDOS:
+		return 2
+	case FamilyKOI:
+		return 3
+	}
+	return 4
+}
+
+// SuggestEncodings ranks the candidate encodings, or all supported encodings if no
+// candidates are specified, by how many runes of the text s each of them can encode.
+// Encodings which represent s losslessly come first. Ties are broken by family,
+// preferring Windows, then ISO, DOS, KOI and Mac encodings, and then by name.
+// Unknown candidates are left out.
+func SuggestEncodings(s string, candidates ...string) []Suggestion {
+	if len(candidates) == 0 {
+		candidates = List()
+	}
+
+	// distinct runes of s in order of appearance, with their counts
+	var runes []rune
+	counts := make(map[rune]int)
+	for _, r := range s {
+		if counts[r] == 0 {
+			runes = append(runes, r)
+		}
+		counts[r]++
+	}
+
+	type ranked struct {
+		Suggestion
+		rank int
+	}
+	list := make([]ranked, 0, len(candidates))
+	seen := make(map[*Encoding]bool)
+
+	for _, name := range candidates {
+		e, err := Lookup(name)
+		if err != nil || seen[e] {
+			continue
+		}
+		seen[e] = true
+
+		sg := Suggestion{Encoding: e.Name()}
+		for _, r := range runes {
+			if _, ok := e.codec.EncodeRune(r); ok {
+				sg.Encodable += counts[r]
+			} else {
+				sg.Unencodable = append(sg.Unencodable, r)
+			}
+		}
+
+		list = append(list, ranked{sg, familyRank(e)})
+	}
+
+	sort.SliceStable(list, func(i, j int) bool {
+		a, b := list[i], list[j]
+		if a.Encodable != b.Encodable {
+			return a.Encodable > b.Encodable
+		}
+		if a.rank != b.rank {
+			return a.rank < b.rank
+		}
+		return a.Encoding < b.Encoding
+	})
+
+	result := make([]Suggestion, len(list))
+	for i := range list {
+		result[i] = list[i].Suggestion
+	}
+	return result
+}
@@ -0,0 +1,39 @@
+package charmap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSuggestEncodings(t *testing.T) {
+	requireEncodings(t, "cp1251", "iso-8859-5", "cp866", "koi8-r")
+
+	list := SuggestEncodings("Съешь же ещё этих мягких булок")
+	if len(list) != len(List()) {
+		t.Fatal("suggesting encodings: wrong number of suggestions")
+	}
+	// CP1251 and ISO-8859-5 both represent the text, Windows is preferred
+	if list[0].Encoding != "CP1251" || list[1].Encoding != "ISO-8859-5" || list[0].Unencodable != nil {
+		t.Errorf("suggesting encodings for russian text: wrong order %v", list[:3])
+	}
+	for i := 1; i < len(list); i++ {
+		if list[i].Encodable > list[i-1].Encodable {
+			t.Error("suggesting encodings: not sorted by encodable runes")
+		}
+	}
+
+	list = SuggestEncodings("Ёлка €", "koi8-r", "cp866", "cp1251", "wrong-encoding", "windows-1251")
+	if len(list) != 3 {
+		t.Fatalf("suggesting candidates: wrong number of suggestions %v", list)
+	}
+	if list[0].Encoding != "CP1251" || list[0].Encodable != 6 || list[0].Unencodable != nil {
+		t.Errorf("suggesting candidates: wrong first suggestion %v", list[0])
+	}
+	// same score, DOS before KOI
+	if list[1].Encoding != "CP866" || list[2].Encoding != "KOI8-R" || list[1].Encodable != 5 {
+		t.Errorf("suggesting candidates: wrong tie break %v", list[1:])
+	}
+	if !reflect.DeepEqual(list[2].Unencodable, []rune{'€'}) {
+		t.Errorf("suggesting candidates: wrong unencodable runes %q", list[2].Unencodable)
+	}
+}
@@ -0,0 +1,109 @@
+package charmap
+
+import (
+	"unicode/utf8"
+)
+
+// Transcoder converts data directly between two 8bit encodings without
+// an intermediate UTF-8 representation, using a precomputed byte to byte table.
+// A Transcoder is safe for concurrent use.
+type Transcoder struct {
+	from, to *Encoding
+	table    [256]byte
+	mask     [256]bool // bytes which have no equivalent in the target encoding
+}
+
+// allMasked makes every byte go through the slow path of the transcoding loop.
+var allMasked [256]bool
+
+func init() {
+	for i := range allMasked {
+		allMasked[i] = true
+	}
+}
+
+// NewTranscoder returns a Transcoder from one encoding to another.
+// If one of the encodings is unknown, it will return ErrUnknownEncoding
+func NewTranscoder(from, to string) (*Transcoder, error) {
+	f, err := Lookup(from)
+	if err != nil {
+		return nil, err
+	}
+	t, err := Lookup(to)
+	if err != nil {
+		return nil, err
+	}
+
+	return f.NewTranscoder(t), nil
+}
+
+// NewTranscoder returns a Transcoder from the encoding to another one.
+func (e *Encoding) NewTranscoder(to *Encoding) *Transcoder {
+	t := &Transcoder{from: e, to: to}
+
+	for i := range t.table {
+		r, ok := e.codec.DecodeByte(byte(i))
+		if ok {
+			t.table[i], ok = to.codec.EncodeRune(r)
+		}
+		t.mask[i] = !ok
+	}
+
+	return t
+}
+
+// Transcode converts data from one encoding to another. Returns converted data.
+// Bytes which are undefined in the source encoding or have no equivalent in the
+// target encoding are handled as in Encode: by default they are replaced with '?' and
+// a *ConversionError wrapping ErrInvalidCodepoint is returned in error value.
+// If one of the encodings is unknown, it will return the input data and ErrUnknownEncoding
+func Transcode(data []byte, from, to string, opts ...Option) ([]byte, error) {
+	t, err := NewTranscoder(from, to)
+	if err != nil {
+		return data, err
+	}
+
+	return t.Transcode(data, opts...)
+}
+
+// Transcode converts data, see the Transcode function.
+func (t *Transcoder) Transcode(data []byte, opts ...Option) ([]byte, error) {
+	return t.AppendTranscode(make([]byte, 0, len(data)), data, opts...)
+}
+
+// AppendTranscode converts src, appends the result to dst and returns the extended buffer.
+func (t *Transcoder) AppendTranscode(dst, src []byte, opts ...Option) ([]byte, error) {
+	var cerr *ConversionError
+
+	o := newOptions(opts)
+	mask := &t.mask
+	if o.surrogateEscape {
+		mask = &allMasked
+	}
+	from, to := o.codec(t.from.codec), o.codec(t.to.codec)
+
+	for i, b := range src {
+		if !mask[b] {
+			dst = append(dst, t.table[b])
+			continue
+		}
+
+		r, ok := from.DecodeByte(b)
+		if ok {
+			if c, ok := to.EncodeRune(r); ok {
+				dst = append(dst, c)
+				continue
+			}
+		} else {
+			r = utf8.RuneError
+		}
+
+		cerr = cerr.record("transcode", i, i, b, r)
+		if o.encodeErr == nil {
+			break
+		}
+		dst = o.encodeErr(dst, to, r)
+	}
+
+	return dst, t.to.conversionError(cerr)
+}
@@ -0,0 +1,55 @@
+package charmap
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestTranscode(t *testing.T) {
+	requireEncodings(t, "cp866", "cp1251", "koi8-r")
+
+	pana_cp866 := []byte("\x82\x20\xE7\xA0\xE9\xA0\xE5\x20\xEE\xA3\xA0")
+	pana_cp1251 := []byte("\xC2\x20\xF7\xE0\xF9\xE0\xF5\x20\xFE\xE3\xE0")
+
+	test_cp1251, err := Transcode(pana_cp866, "cp866", "cp1251")
+	if err != nil || string(test_cp1251) != string(pana_cp1251) {
+		t.Error("transcoding from cp866 to cp1251: wrong result")
+	}
+
+	tr, err := NewTranscoder("cp1251", "koi8-r")
+	if err != nil {
+		t.Fatal("creating transcoder from cp1251 to koi8-r: wrong error value")
+	}
+	test_koi8r, err := tr.AppendTranscode([]byte("> "), pana_cp1251)
+	if err != nil || string(test_koi8r) != "> \xF7\x20\xDE\xC1\xDD\xC1\xC8\x20\xC0\xC7\xC1" {
+		t.Error("transcoding from cp1251 to koi8-r: wrong result")
+	}
+
+	// box drawing character has no equivalent in cp1251
+	test_illegal, err := Transcode([]byte("A\xB0B\x98"), "cp866", "cp1251")
+	var cerr *ConversionError
+	if !errors.As(err, &cerr) || cerr.Offset != 1 || cerr.Byte != 0xB0 || cerr.Rune != '░' || cerr.Count != 1 {
+		t.Error("transcoding illegal codepoint from cp866: wrong error value")
+	}
+	if string(test_illegal) != "A?B\xD8" {
+		t.Error("transcoding illegal codepoint from cp866: wrong result")
+	}
+
+	test_strict, err := Transcode([]byte("A\x98B"), "cp1251", "cp866", Strict())
+	if !errors.As(err, &cerr) || cerr.Rune != '�' || string(test_strict) != "A" {
+		t.Error("transcoding undefined byte with strict option: wrong result")
+	}
+
+	_, err = Transcode(pana_cp866, "cp866", "wrong-encoding")
+	if err != ErrUnknownEncoding {
+		t.Error("transcoding to wrong-encoding: wrong error value")
+	}
+
+	buf := make([]byte, 0, 64)
+	allocs := testing.AllocsPerRun(100, func() {
+		buf, _ = tr.AppendTranscode(buf[:0], pana_cp1251)
+	})
+	if allocs != 0 {
+		t.Errorf("transcoding into preallocated buffer: %v allocations", allocs)
+	}
+}
@@ -0,0 +1,216 @@
+package charmap
+
+import (
+	"unicode/utf8"
+)
+
+// Valid reports whether every byte of data is defined in the specified encoding,
+// that is whether data can be decoded without errors.
+// If the specified encoding is unknown, it will return false
+func Valid(data []byte, encoding string) bool {
+	return FirstInvalid(data, encoding) < 0
+}
+
+// ValidString is like Valid but checks a string.
+func ValidString(data string, encoding string) bool {
+	e, err := Lookup(encoding)
+	if err != nil {
+		return false
+	}
+
+	return e.ValidString(data)
+}
+
+// FirstInvalid returns the offset of the first byte of data which is undefined
+// in the specified encoding, or -1 if data can be decoded without errors.
+// If the specified encoding is unknown, it will return len(data), which is never
+// the offset of a byte. Use Lookup and the FirstInvalid method to get the lookup error.
+func FirstInvalid(data []byte, encoding string) int {
+	e, err := Lookup(encoding)
+	if err != nil {
+		return len(data)
+	}
+
+	return e.FirstInvalid(data)
+}
+
+// Encodable reports whether data is valid UTF-8 and every rune of it can be encoded
+// to the specified encoding, that is whether data can be encoded without errors.
+// If the specified encoding is unknown, it will return false
+func Encodable(data []byte, encoding string) bool {
+	return FirstUnencodable(data, encoding) < 0
+}
+
+// EncodableString is like Encodable but checks a string.
+func EncodableString(data string, encoding string) bool {
+	e, err := Lookup(encoding)
+	if err != nil {
+		return false
+	}
+
+	return e.EncodableString(data)
+}
+
+// FirstUnencodable returns the byte offset of the first rune of the UTF-8 data which
+// cannot be encoded to the specified encoding, or -1 if data can be encoded without errors.
+// Invalid UTF-8 sequences cannot be encoded.
+// If the specified encoding is unknown, it will return len(data), which is never
+// the offset of a rune. Use Lookup and the FirstUnencodable method to get the lookup error.
+func FirstUnencodable(data []byte, encoding string) int {
+	e, err := Lookup(encoding)
+	if err != nil {
+		return len(data)
+	}
+
+	return e.FirstUnencodable(data)
+}
+
+// Valid reports whether data can be decoded without errors, see the Valid function.
+func (e *Encoding) Valid(data []byte) bool {
+	return e.FirstInvalid(data) < 0
+}
+
+// ValidString is like Valid but checks a string.
+func (e *Encoding) ValidString(data string) bool {
+	return firstInvalidString(e.codec, data) < 0
+}
+
+// FirstInvalid returns the offset of the first undefined byte, see the FirstInvalid function.
+func (e *Encoding) FirstInvalid(data []byte) int {
+	return firstInvalid(e.codec, data)
+}
+
+// Encodable reports whether data can be encoded without errors, see the Encodable function.
+func (e *Encoding) Encodable(data []byte) bool {
+	return e.FirstUnencodable(data) < 0
+}
+
+// EncodableString is like Encodable but checks a string.
+func (e *Encoding) EncodableString(data string) bool {
+	return firstUnencodableString(e.codec, data) < 0
+}
+
+// FirstUnencodable returns the offset of the first rune which cannot be encoded,
+// see the FirstUnencodable function.
+func (e *Encoding) FirstUnencodable(data []byte) int {
+	return firstUnencodable(e.codec, data)
+}
+
+func firstInvalid(c Codec, data []byte) int {
+	if m, ok := c.(*codecMap8Bit); ok {
+		for i, b := range data {
+			if m.utf8Len[b] == 0 {
+				return i
+			}
+		}
+		return -1
+	}
+
+	for i, b := range data {
+		if _, ok := c.DecodeByte(b); !ok {
+			return i
+		}
+	}
+	return -1
+}
+
+func firstInvalidString(c Codec, data string) int {
+	for i := 0; i < len(data); i++ {
+		if _, ok := c.DecodeByte(data[i]); !ok {
+			return i
+		}
+	}
+	return -1
+}
+
+func firstUnencodable(c Codec, data []byte) int {
+	for i := 0; i < len(data); {
+		r, size := rune(data[i]), 1
+		if r >= utf8.RuneSelf {
+			r, size = utf8.DecodeRune(data[i:])
+		}
+		if r == utf8.RuneError && size == 1 {
+			return i
+		}
+		if _, ok := c.EncodeRune(r); !ok {
+			return i
+		}
+		i += size
+	}
+	return -1
+}
+
+func firstUnencodableString(c Codec, data string) int {
+	for i, r := range data {
+		if r == utf8.RuneError {
+			if _, size := utf8.DecodeRuneInString(data[i:]); size == 1 {
+				return i
+			}
+		}
+		if _, ok := c.EncodeRune(r); !ok {
+			return i
+		}
+	}
+	return -1
+}
+
+// Span describes a character of UTF-8 text.
+type Span struct {
+	Rune       rune   // utf8.RuneError for an invalid UTF-8 sequence
+	Offset     int    // byte offset of the character in the text
+	End        int    // byte offset after the character
+	RuneOffset int    // character offset in the text
+	Name       string // Unicode name as returned by RuneName, "" if the character has none
+}
+
+// CanEncode reports whether the string s can be encoded to the specified encoding
+// without errors. It is the same as EncodableString.
+// If the specified encoding is unknown, it will return false
+func CanEncode(s string, encoding string) bool {
+	return EncodableString(s, encoding)
+}
+
+// Unencodable returns the characters of the string s which cannot be encoded to the
+// specified encoding, or nil if all of them can. Invalid UTF-8 sequences are reported
+// as single bytes with the rune utf8.RuneError.
+// If the specified encoding is unknown, it will return ErrUnknownEncoding
+func Unencodable(s string, encoding string) ([]Span, error) {
+	e, err := Lookup(encoding)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.Unencodable(s), nil
+}
+
+// CanEncode reports whether the string s can be encoded without errors.
+func (e *Encoding) CanEncode(s string) bool {
+	return e.EncodableString(s)
+}
+
+// Unencodable returns the characters of the string s which cannot be encoded,
+// see the Unencodable function.
+func (e *Encoding) Unencodable(s string) []Span {
+	var spans []Span
+
+	n := 0
+	for i, r := range s {
+		size := utf8.RuneLen(r)
+		invalid := false
+		if r == utf8.RuneError {
+			_, size = utf8.DecodeRuneInString(s[i:])
+			invalid = size == 1
+		}
+
+		if _, ok := e.codec.EncodeRune(r); !ok || invalid {
+			span := Span{Rune: r, Offset: i, End: i + size, RuneOffset: n}
+			if !invalid {
+				span.Name = RuneName(r)
+			}
+			spans = append(spans, span)
+		}
+		n++
+	}
+
+	return spans
+}
@@ -0,0 +1,101 @@
+package charmap
+
+import (
+	"testing"
+	"unicode/utf8"
+)
+
+func TestValid(t *testing.T) {
+	requireEncodings(t, "cp1251")
+
+	pana_cp1251 := "\xC2 \xF7\xE0\xF9\xE0\xF5 \xFE\xE3\xE0 \xE6\xE8\xEB \xE1\xFB \xF6\xE8\xF2\xF0\xF3\xF1?"
+
+	if !Valid([]byte(pana_cp1251), "cp1251") || !ValidString(pana_cp1251, "cp1251") {
+		t.Error("validating cp1251: wrong result")
+	}
+	if Valid([]byte("AB\x98C"), "cp1251") || ValidString("AB\x98C", "cp1251") {
+		t.Error("validating cp1251 with undefined byte: wrong result")
+	}
+	if n := FirstInvalid([]byte("AB\x98C\x98"), "cp1251"); n != 2 {
+		t.Errorf("first invalid byte in cp1251: %d", n)
+	}
+	if n := FirstInvalid([]byte(pana_cp1251), "cp1251"); n != -1 {
+		t.Errorf("first invalid byte in valid cp1251: %d", n)
+	}
+	if !Valid(nil, "cp1251") {
+		t.Error("validating empty input: wrong result")
+	}
+	if Valid([]byte("A"), "wrong-encoding") || FirstInvalid([]byte("A"), "wrong-encoding") != 1 {
+		t.Error("validating wrong-encoding: wrong result")
+	}
+	if Valid(nil, "wrong-encoding") || FirstInvalid(nil, "wrong-encoding") != 0 {
+		t.Error("validating wrong-encoding: wrong result")
+	}
+
+	// codecs other than the builtin ones take the generic path
+	e, _ := Lookup("cp1251")
+	c := struct{ Codec }{e.Codec()}
+	if n := firstInvalid(c, []byte("AB\x98C")); n != 2 {
+		t.Errorf("first invalid byte with generic codec: %d", n)
+	}
+}
+
+func TestEncodable(t *testing.T) {
+	requireEncodings(t, "cp1251", "koi8-r")
+
+	if !Encodable([]byte("В чащах юга"), "cp1251") || !EncodableString("В чащах юга", "koi8-r") {
+		t.Error("checking encodable text: wrong result")
+	}
+	if n := FirstUnencodable([]byte("Да, αβ!"), "cp1251"); n != 6 {
+		t.Errorf("first unencodable rune: %d", n)
+	}
+	if EncodableString("Да, αβ!", "cp1251") {
+		t.Error("checking unencodable text: wrong result")
+	}
+	if n := FirstUnencodable([]byte("Да\xD0"), "cp1251"); n != 4 {
+		t.Errorf("first unencodable rune in invalid UTF-8: %d", n)
+	}
+	if EncodableString("Да\xFF", "cp1251") || !EncodableString("", "cp1251") {
+		t.Error("checking invalid UTF-8: wrong result")
+	}
+	if Encodable([]byte("A"), "wrong-encoding") || FirstUnencodable([]byte("A"), "wrong-encoding") != 1 {
+		t.Error("checking wrong-encoding: wrong result")
+	}
+}
+
+func TestUnencodable(t *testing.T) {
+	requireEncodings(t, "cp1252", "cp1251")
+
+	if !CanEncode("Grüße, €5", "cp1252") || CanEncode("Grüße, €5 ≈ ₽", "cp1252") {
+		t.Error("checking cp1252 text: wrong result")
+	}
+	if CanEncode("test", "wrong-encoding") {
+		t.Error("checking wrong-encoding: wrong result")
+	}
+
+	spans, err := Unencodable("€5 ≈ ₽\xFF", "cp1252")
+	if err != nil || len(spans) != 3 {
+		t.Fatalf("unencodable characters: wrong result %v, %v", spans, err)
+	}
+	if spans[0] != (Span{Rune: '≈', Offset: 5, End: 8, RuneOffset: 3, Name: "ALMOST EQUAL TO"}) {
+		t.Errorf("unencodable characters: wrong span %+v", spans[0])
+	}
+	if spans[1].Rune != '₽' || spans[1].Offset != 9 || spans[1].End != 12 || spans[1].RuneOffset != 5 || spans[1].Name != "RUBLE SIGN" {
+		t.Errorf("unencodable characters: wrong span %+v", spans[1])
+	}
+	if spans[2].Rune != utf8.RuneError || spans[2].Offset != 12 || spans[2].End != 13 {
+		t.Errorf("unencodable characters: wrong span for invalid UTF-8 %+v", spans[2])
+	}
+
+	spans, _ = Unencodable("Да, αβ", "cp1251")
+	if len(spans) != 2 || spans[0].Name != "GREEK SMALL LETTER ALPHA" || spans[1].RuneOffset != 5 {
+		t.Errorf("unencodable greek characters: wrong result %+v", spans)
+	}
+
+	if spans, err := Unencodable("Grüße", "cp1252"); spans != nil || err != nil {
+		t.Error("unencodable characters of encodable text: wrong result")
+	}
+	if _, err := Unencodable("test", "wrong-encoding"); err != ErrUnknownEncoding {
+		t.Error("unencodable characters for wrong-encoding: wrong error value")
+	}
+}
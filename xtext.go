@@ -0,0 +1,102 @@
+package charmap
+
+import (
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/transform"
+)
+
+// Encoding implements the encoding.Encoding interface of golang.org/x/text,
+// so it can be used with transform.NewReader, transform.Chain and the like.
+var _ encoding.Encoding = (*Encoding)(nil)
+
+// NewDecoder returns a golang.org/x/text Decoder converting from the encoding to UTF-8.
+// Illegal characters are replaced with a substitute character (utf8.RuneError).
+func (e *Encoding) NewDecoder() *encoding.Decoder {
+	return &encoding.Decoder{Transformer: decoder{c: e.codec}}
+}
+
+// NewEncoder returns a golang.org/x/text Encoder converting from UTF-8 to the encoding.
+// The transformation stops with an error at the first character that cannot be encoded;
+// wrap the Encoder with encoding.ReplaceUnsupported to substitute such characters with '?'.
+func (e *Encoding) NewEncoder() *encoding.Encoder {
+	return &encoding.Encoder{Transformer: encoder{c: e.codec}}
+}
+
+// repertoireError is returned by the Encoder for characters that cannot be encoded.
+// Its Replacement method is recognized by encoding.ReplaceUnsupported.
+type repertoireError byte
+
+func (e repertoireError) Error() string {
+	return ErrInvalidCodepoint.Error()
+}
+
+func (e repertoireError) Unwrap() error {
+	return ErrInvalidCodepoint
+}
+
+func (e repertoireError) Replacement() byte {
+	return byte(e)
+}
+
+type decoder struct {
+	transform.NopResetter
+	c Codec
+}
+
+func (d decoder) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	for ; nSrc < len(src); nSrc++ {
+		r, ok := d.c.DecodeByte(src[nSrc])
+		if !ok {
+			r = utf8.RuneError
+		}
+
+		if r < utf8.RuneSelf {
+			if nDst >= len(dst) {
+				return nDst, nSrc, transform.ErrShortDst
+			}
+			dst[nDst] = byte(r)
+			nDst++
+			continue
+		}
+
+		if nDst+utf8.RuneLen(r) > len(dst) {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+		nDst += utf8.EncodeRune(dst[nDst:], r)
+	}
+
+	return nDst, nSrc, nil
+}
+
+type encoder struct {
+	transform.NopResetter
+	c Codec
+}
+
+func (e encoder) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	for nSrc < len(src) {
+		if nDst >= len(dst) {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+
+		r, size := rune(src[nSrc]), 1
+		if r >= utf8.RuneSelf {
+			if !atEOF && !utf8.FullRune(src[nSrc:]) {
+				return nDst, nSrc, transform.ErrShortSrc
+			}
+			r, size = utf8.DecodeRune(src[nSrc:])
+		}
+
+		c, ok := e.c.EncodeRune(r)
+		if !ok || (r == utf8.RuneError && size == 1) {
+			return nDst, nSrc, repertoireError('?')
+		}
+		dst[nDst] = c
+		nDst++
+		nSrc += size
+	}
+
+	return nDst, nSrc, nil
+}
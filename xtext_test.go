@@ -0,0 +1,71 @@
+package charmap
+
+import (
+	"errors"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/transform"
+)
+
+func TestXTextEncoding(t *testing.T) {
+	requireEncodings(t, "cp1251", "koi8-r")
+
+	pana_cp1251 := "\xC2\x20\xF7\xE0\xF9\xE0\xF5\x20\xFE\xE3\xE0"
+	pana_koi8r := "\xF7\x20\xDE\xC1\xDD\xC1\xC8\x20\xC0\xC7\xC1"
+	pana_utf8 := "В чащах юга"
+
+	cp1251, _ := Lookup("cp1251")
+	koi8r, _ := Lookup("koi8-r")
+
+	test_decode, _, err := transform.String(cp1251.NewDecoder(), pana_cp1251)
+	if err != nil || test_decode != pana_utf8 {
+		t.Error("x/text decoding from cp1251: wrong result")
+	}
+
+	test_encode, _, err := transform.String(koi8r.NewEncoder(), pana_utf8)
+	if err != nil || test_encode != pana_koi8r {
+		t.Error("x/text encoding to koi8-r: wrong result")
+	}
+
+	chain := transform.Chain(koi8r.NewDecoder(), cp1251.NewEncoder())
+	test_chain, err := ioutil.ReadAll(transform.NewReader(strings.NewReader(pana_koi8r), chain))
+	if err != nil || string(test_chain) != pana_cp1251 {
+		t.Error("x/text chain from koi8-r to cp1251: wrong result")
+	}
+
+	_, _, err = transform.String(cp1251.NewEncoder(), "AαZ")
+	if !errors.Is(err, ErrInvalidCodepoint) {
+		t.Error("x/text encoding illegal codepoint: wrong error value")
+	}
+
+	test_replace, _, err := transform.String(encoding.ReplaceUnsupported(cp1251.NewEncoder()), "AαZ")
+	if err != nil || test_replace != "A?Z" {
+		t.Error("x/text encoding with ReplaceUnsupported: wrong result")
+	}
+}
+
+func TestXTextShortBuffers(t *testing.T) {
+	requireEncodings(t, "cp1251")
+
+	cp1251, _ := Lookup("cp1251")
+
+	dst := make([]byte, 3)
+	nDst, nSrc, err := cp1251.NewDecoder().Transform(dst, []byte("A\xC2\xF7"), true)
+	if err != transform.ErrShortDst || nDst != 3 || nSrc != 2 {
+		t.Error("x/text decoding into short buffer: wrong return values")
+	}
+
+	// "AВ" with the second character split
+	nDst, nSrc, err = cp1251.NewEncoder().Transform(dst, []byte("A\xD0"), false)
+	if err != transform.ErrShortSrc || nDst != 1 || nSrc != 1 {
+		t.Error("x/text encoding incomplete input: wrong return values")
+	}
+
+	nDst, nSrc, err = cp1251.NewEncoder().Transform(dst[:1], []byte("AB"), true)
+	if err != transform.ErrShortDst || nDst != 1 || nSrc != 1 {
+		t.Error("x/text encoding into short buffer: wrong return values")
+	}
+}